@@ -1,12 +1,19 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
 	"github.com/ilyakaznacheev/cleanenv"
 	_ "github.com/joho/godotenv/autoload"
 )
 
 type Config struct {
-	HTTPPort string `env:"HTTP_PORT" env-default:":8080"`
+	HTTPPort       string   `env:"HTTP_PORT" env-default:":8080"`
+	TrustedProxies []string `env:"TRUSTED_PROXIES" env-separator:","`
 
 	PSQLHost     string `env:"POSTGRESQL_HOST"`
 	PSQLPort     string `env:"POSTGRESQL_PORT"`
@@ -14,11 +21,130 @@ type Config struct {
 	PSQLPassword string `env:"POSTGRESQL_PASSWORD"`
 	PSQLDBName   string `env:"POSTGRESQL_DBNAME"`
 	PSQLSSLMode  string `env:"POSTGRESQL_SSL_MODE"`
+
+	JWTSigningAlgorithm      string        `env:"JWT_SIGNING_ALGORITHM" env-default:"RS256"`
+	JWTKeyRotationInterval   time.Duration `env:"JWT_KEY_ROTATION_INTERVAL" env-default:"720h"`
+	JWTKeyVerificationPeriod time.Duration `env:"JWT_KEY_VERIFICATION_PERIOD" env-default:"24h"`
+	JWTIssuer                string        `env:"JWT_ISSUER" env-default:"todo-app"`
+	JWTAudience              string        `env:"JWT_AUDIENCE" env-default:"todo-app"`
+	JWTClockSkew             time.Duration `env:"JWT_CLOCK_SKEW" env-default:"30s"`
+
+	AppURL string `env:"APP_URL" env-default:"http://localhost:3000"`
+	APIURL string `env:"API_URL" env-default:"http://localhost:8080"`
+
+	EmailVerificationPolicy string `env:"EMAIL_VERIFICATION_POLICY" env-default:"off"`
+
+	MailDriver   string `env:"MAIL_DRIVER" env-default:"log"`
+	MailFrom     string `env:"MAIL_FROM" env-default:"no-reply@localhost"`
+	MailLogFile  string `env:"MAIL_LOG_FILE"`
+	SMTPHost     string `env:"SMTP_HOST"`
+	SMTPPort     string `env:"SMTP_PORT" env-default:"587"`
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+
+	LoginMaxAccountFailures int           `env:"LOGIN_MAX_ACCOUNT_FAILURES" env-default:"5"`
+	LoginMaxIPFailures      int           `env:"LOGIN_MAX_IP_FAILURES" env-default:"20"`
+	LoginFailureWindow      time.Duration `env:"LOGIN_FAILURE_WINDOW" env-default:"15m"`
+	LoginLockoutBase        time.Duration `env:"LOGIN_LOCKOUT_BASE" env-default:"1m"`
+	LoginLockoutMax         time.Duration `env:"LOGIN_LOCKOUT_MAX" env-default:"1h"`
+	LoginAttemptStore       string        `env:"LOGIN_ATTEMPT_STORE" env-default:"postgres"`
+
+	PasswordMinLength           int     `env:"PASSWORD_MIN_LENGTH" env-default:"10"`
+	PasswordMaxLength           int     `env:"PASSWORD_MAX_LENGTH" env-default:"72"`
+	PasswordMinCharacterClasses int     `env:"PASSWORD_MIN_CHARACTER_CLASSES" env-default:"2"`
+	PasswordMinEntropyBits      float64 `env:"PASSWORD_MIN_ENTROPY_BITS" env-default:"40"`
+	PasswordBreachListDir       string  `env:"PASSWORD_BREACH_LIST_DIR"`
+
+	PasswordHashAlgorithm     string `env:"PASSWORD_HASH_ALGORITHM" env-default:"argon2id"`
+	PasswordBcryptCost        int    `env:"PASSWORD_BCRYPT_COST" env-default:"12"`
+	PasswordArgon2Memory      uint32 `env:"PASSWORD_ARGON2_MEMORY" env-default:"65536"`
+	PasswordArgon2Iterations  uint32 `env:"PASSWORD_ARGON2_ITERATIONS" env-default:"3"`
+	PasswordArgon2Parallelism uint8  `env:"PASSWORD_ARGON2_PARALLELISM" env-default:"4"`
+
+	RegistrationMode           string   `env:"REGISTRATION_MODE" env-default:"open"`
+	RegistrationAllowedDomains []string `env:"REGISTRATION_ALLOWED_DOMAINS" env-separator:","`
+
+	AdminEmails []string `env:"ADMIN_EMAILS" env-separator:","`
+
+	PlanFreeMaxLists          int `env:"PLAN_FREE_MAX_LISTS" env-default:"5"`
+	PlanFreeMaxItemsPerList   int `env:"PLAN_FREE_MAX_ITEMS_PER_LIST" env-default:"100"`
+	PlanFreeMaxMembersPerList int `env:"PLAN_FREE_MAX_MEMBERS_PER_LIST" env-default:"3"`
+	PlanFreeMaxAttachments    int `env:"PLAN_FREE_MAX_ATTACHMENTS" env-default:"10"`
+	PlanProMaxLists           int `env:"PLAN_PRO_MAX_LISTS" env-default:"50"`
+	PlanProMaxItemsPerList    int `env:"PLAN_PRO_MAX_ITEMS_PER_LIST" env-default:"1000"`
+	PlanProMaxMembersPerList  int `env:"PLAN_PRO_MAX_MEMBERS_PER_LIST" env-default:"10"`
+	PlanProMaxAttachments     int `env:"PLAN_PRO_MAX_ATTACHMENTS" env-default:"1000"`
+	PlanTeamMaxLists          int `env:"PLAN_TEAM_MAX_LISTS" env-default:"500"`
+	PlanTeamMaxItemsPerList   int `env:"PLAN_TEAM_MAX_ITEMS_PER_LIST" env-default:"5000"`
+	PlanTeamMaxMembersPerList int `env:"PLAN_TEAM_MAX_MEMBERS_PER_LIST" env-default:"50"`
+	PlanTeamMaxAttachments    int `env:"PLAN_TEAM_MAX_ATTACHMENTS" env-default:"10000"`
+
+	TrashRetention     time.Duration `env:"TRASH_RETENTION" env-default:"720h"`
+	TrashPurgeInterval time.Duration `env:"TRASH_PURGE_INTERVAL" env-default:"1h"`
+
+	OIDCProviderNames []string `env:"OIDC_PROVIDERS" env-separator:","`
+	OIDCProviders     []OIDCProvider
+}
+
+// OIDCProvider is read from OIDC_<NAME>_* variables for every name listed in OIDC_PROVIDERS.
+type OIDCProvider struct {
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
 }
 
 func NewConfig() (*Config, error) {
 	cfg := &Config{}
 
-	err := cleanenv.ReadEnv(cfg)
-	return cfg, err
+	if err := cleanenv.ReadEnv(cfg); err != nil {
+		return cfg, err
+	}
+
+	switch cfg.EmailVerificationPolicy {
+	case "off", "restrict", "block":
+	default:
+		return cfg, fmt.Errorf("unknown EMAIL_VERIFICATION_POLICY %q", cfg.EmailVerificationPolicy)
+	}
+
+	// Anyone can sign up with an address at an allowed domain, only proving they own it
+	// keeps registration limited to the domain
+	if cfg.RegistrationMode == "domains" && cfg.EmailVerificationPolicy != "block" {
+		return cfg, errors.New("REGISTRATION_MODE=domains requires EMAIL_VERIFICATION_POLICY=block")
+	}
+
+	if cfg.TrashPurgeInterval <= 0 {
+		return cfg, errors.New("TRASH_PURGE_INTERVAL must be positive")
+	}
+
+	for _, name := range cfg.OIDCProviderNames {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		cfg.OIDCProviders = append(cfg.OIDCProviders, readOIDCProvider(name))
+	}
+
+	return cfg, nil
+}
+
+func readOIDCProvider(name string) OIDCProvider {
+	prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+
+	scopes := strings.Fields(os.Getenv(prefix + "SCOPES"))
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	return OIDCProvider{
+		Name:         name,
+		IssuerURL:    os.Getenv(prefix + "ISSUER_URL"),
+		ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+		ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+		RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+		Scopes:       scopes,
+	}
 }
@@ -2,9 +2,11 @@ package app
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -14,9 +16,12 @@ import (
 	"github.com/rtsoy/todo-app/config"
 	_ "github.com/rtsoy/todo-app/docs"
 	"github.com/rtsoy/todo-app/internal/handler"
+	"github.com/rtsoy/todo-app/internal/model"
 	"github.com/rtsoy/todo-app/internal/repository"
 	"github.com/rtsoy/todo-app/internal/service"
 	"github.com/rtsoy/todo-app/pkg/logger"
+	"github.com/rtsoy/todo-app/pkg/mail"
+	"github.com/rtsoy/todo-app/pkg/passhash"
 	"github.com/rtsoy/todo-app/pkg/postgresql"
 	"github.com/sirupsen/logrus"
 	echoSwagger "github.com/swaggo/echo-swagger"
@@ -33,17 +38,83 @@ func Run(cfg *config.Config) {
 
 	log := logger.New(logrus.InfoLevel, e)
 
+	ipExtractor, err := newIPExtractor(cfg.TrustedProxies)
+	if err != nil {
+		log.Fatalf("Error while reading the trusted proxies: %s", err.Error())
+	}
+
+	// The client address is what sign-ins are locked by and what sessions and the audit log
+	// record, so it may only come from headers set by a proxy we know of
+	e.IPExtractor = ipExtractor
+
 	db, err := postgresql.New(cfg)
 	if err != nil {
 		log.Fatalf("Error while connecting to the database: %s", err.Error())
 	}
 
+	mailer, err := mail.New(cfg)
+	if err != nil {
+		log.Fatalf("Error while creating the mail sender: %s", err.Error())
+	}
+
+	hasher, err := passhash.NewHasher(cfg.PasswordHashAlgorithm, cfg.PasswordBcryptCost, passhash.Argon2idParams{
+		Memory:      cfg.PasswordArgon2Memory,
+		Iterations:  cfg.PasswordArgon2Iterations,
+		Parallelism: cfg.PasswordArgon2Parallelism,
+		SaltLength:  passhash.DefaultArgon2idParams.SaltLength,
+		KeyLength:   passhash.DefaultArgon2idParams.KeyLength,
+	})
+	if err != nil {
+		log.Fatalf("Error while creating the password hasher: %s", err.Error())
+	}
+
 	rpstry := repository.NewRepository(db)
-	svc := service.NewService(rpstry)
+	if cfg.LoginAttemptStore == "memory" {
+		rpstry.LoginAttemptRepository = repository.NewLoginAttemptRepositoryMemory()
+	}
+
+	svc := service.NewService(cfg, rpstry, mailer, hasher, log)
+	if err := svc.KeyService.Load(); err != nil {
+		log.Fatalf("Error while loading the signing keys: %s", err.Error())
+	}
+
+	if err := svc.AdminService.PromoteAdmins(cfg.AdminEmails); err != nil {
+		log.Fatalf("Error while promoting the admins: %s", err.Error())
+	}
+
+	if err := svc.PlanService.Sync([]model.Plan{
+		{
+			Name:              model.PlanFree,
+			MaxLists:          cfg.PlanFreeMaxLists,
+			MaxItemsPerList:   cfg.PlanFreeMaxItemsPerList,
+			MaxMembersPerList: cfg.PlanFreeMaxMembersPerList,
+			MaxAttachments:    cfg.PlanFreeMaxAttachments,
+		},
+		{
+			Name:              model.PlanPro,
+			MaxLists:          cfg.PlanProMaxLists,
+			MaxItemsPerList:   cfg.PlanProMaxItemsPerList,
+			MaxMembersPerList: cfg.PlanProMaxMembersPerList,
+			MaxAttachments:    cfg.PlanProMaxAttachments,
+		},
+		{
+			Name:              model.PlanTeam,
+			MaxLists:          cfg.PlanTeamMaxLists,
+			MaxItemsPerList:   cfg.PlanTeamMaxItemsPerList,
+			MaxMembersPerList: cfg.PlanTeamMaxMembersPerList,
+			MaxAttachments:    cfg.PlanTeamMaxAttachments,
+		},
+	}); err != nil {
+		log.Fatalf("Error while syncing the plans: %s", err.Error())
+	}
+
 	hndlr := handler.NewHandler(svc)
 
 	hndlr.InitRoutes(e)
 
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	go purgeTrash(purgeCtx, svc.TrashService, cfg.TrashPurgeInterval, log)
+
 	go func() {
 		if err := e.Start(cfg.HTTPPort); err != nil && err != http.ErrServerClosed {
 			log.Fatalf("Error while starting the echo server: %s", err.Error())
@@ -56,6 +127,8 @@ func Run(cfg *config.Config) {
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT, os.Interrupt)
 	<-quit
 
+	stopPurge()
+
 	ctx, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
 	defer cancel()
 
@@ -65,6 +138,16 @@ func Run(cfg *config.Config) {
 		log.Println("Server shut down gracefully.")
 	}
 
+	// Exports are built in the background and need the database until they are stored
+	if err := svc.DataExportService.Wait(ctx); err != nil {
+		log.Errorf("Error while waiting for the data exports: %s", err.Error())
+	}
+
+	// Requests answered already may still have their email on the way
+	if err := svc.BackgroundMailer.Wait(ctx); err != nil {
+		log.Errorf("Error while waiting for the emails to be sent: %s", err.Error())
+	}
+
 	if err := db.Close(); err != nil {
 		log.Fatalf("Error while closing the database: %s", err.Error())
 	} else {
@@ -73,3 +156,45 @@ func Run(cfg *config.Config) {
 
 	log.Println("Exiting... Have a nice day!")
 }
+
+// newIPExtractor reads the client address from X-Forwarded-For when requests come through
+// one of the trusted proxies, and from the connection otherwise.
+func newIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+
+	for _, proxy := range trustedProxies {
+		_, ipRange, err := net.ParseCIDR(strings.TrimSpace(proxy))
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+// purgeTrash removes expired entries from the trash every interval until ctx is done.
+func purgeTrash(ctx context.Context, trash service.TrashServicer, interval time.Duration, log *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := trash.Purge(); err != nil {
+				log.Errorf("Error while purging the trash: %s", err.Error())
+			}
+		}
+	}
+}
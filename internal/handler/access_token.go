@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// @Summary Create an access token
+// @Description Create a personal access token for scripts and integrations. The token is only shown once.
+// @Tags Access Tokens
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param input body model.CreatePersonalAccessTokenDTO true "Token name, scopes and optional expiration"
+// @Success 201 {object} model.CreatedPersonalAccessToken
+// @Failure 400 {object} swaggerErrorResponse
+// @Router /api/me/tokens [post]
+func (h *Handler) createAccessToken(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	var input model.CreatePersonalAccessTokenDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	token, err := h.AccessTokenService.Create(userID, input)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	setAuditTarget(c, "token", token.ID.String())
+
+	return c.JSON(http.StatusCreated, token)
+}
+
+// @Summary Get all access tokens
+// @Description Get all active personal access tokens of the current user
+// @Tags Access Tokens
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} resourceResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/me/tokens [get]
+func (h *Handler) getAllAccessTokens(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	tokens, err := h.AccessTokenService.GetAll(userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(tokens),
+		Results:    tokens,
+		Pagination: nil,
+	})
+}
+
+// @Summary Revoke an access token
+// @Description Revoke a personal access token by its ID
+// @Tags Access Tokens
+// @Produce json
+// @Security ApiKeyAuth
+// @Param tokenID path string true "Token ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/me/tokens/{tokenID} [delete]
+func (h *Handler) deleteAccessToken(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	tokenID, err := getValueFromParams(c, "tokenID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.AccessTokenService.Revoke(userID, tokenID); err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
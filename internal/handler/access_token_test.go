@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_createAccessToken(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockAccessTokenServicer, userID uuid.UUID, input model.CreatePersonalAccessTokenDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.CreatePersonalAccessTokenDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"name": "backup", "scopes": ["read"]}`,
+			inputData: model.CreatePersonalAccessTokenDTO{Name: "backup", Scopes: []string{"read"}},
+			mockBehavior: func(s *mock_service.MockAccessTokenServicer, userID uuid.UUID, input model.CreatePersonalAccessTokenDTO) {
+				s.EXPECT().Create(userID, input).Return(model.CreatedPersonalAccessToken{
+					PersonalAccessToken: model.PersonalAccessToken{
+						ID:        uuid.Nil,
+						Name:      "backup",
+						Scopes:    []string{"read"},
+						CreatedAt: time.Unix(0, 0).UTC(),
+					},
+					Token: "tdo_token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusCreated,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","name":"backup","scopes":["read"],"createdAt":"1970-01-01T00:00:00Z","expiresAt":null,"lastUsedAt":null,"token":"tdo_token"}` + "\n",
+		},
+		{
+			name:      "Invalid JSON",
+			inputBody: `{`,
+			mockBehavior: func(s *mock_service.MockAccessTokenServicer, userID uuid.UUID, input model.CreatePersonalAccessTokenDTO) {
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "Service Failure",
+			inputBody: `{"name": "backup", "scopes": ["admin"]}`,
+			inputData: model.CreatePersonalAccessTokenDTO{Name: "backup", Scopes: []string{"admin"}},
+			mockBehavior: func(s *mock_service.MockAccessTokenServicer, userID uuid.UUID, input model.CreatePersonalAccessTokenDTO) {
+				s.EXPECT().Create(userID, input).Return(model.CreatedPersonalAccessToken{}, errors.New("unknown scope: admin"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"unknown scope: admin"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			accessToken := mock_service.NewMockAccessTokenServicer(c)
+			test.mockBehavior(accessToken, userID, test.inputData)
+
+			services := &service.Service{AccessTokenService: accessToken}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/tokens", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.createAccessToken(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_deleteAccessToken(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockAccessTokenServicer, userID, tokenID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		tokenID             uuid.UUID
+		tokenIDStr          string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:       "OK",
+			tokenID:    uuid.Nil,
+			tokenIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockAccessTokenServicer, userID, tokenID uuid.UUID) {
+				s.EXPECT().Revoke(userID, tokenID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			tokenID:             uuid.Nil,
+			tokenIDStr:          "12312312",
+			mockBehavior:        func(s *mock_service.MockAccessTokenServicer, userID, tokenID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:       "Not Found",
+			tokenID:    uuid.Nil,
+			tokenIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockAccessTokenServicer, userID, tokenID uuid.UUID) {
+				s.EXPECT().Revoke(userID, tokenID).Return(errors.New("access token not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"access token not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			accessToken := mock_service.NewMockAccessTokenServicer(c)
+			test.mockBehavior(accessToken, userID, test.tokenID)
+
+			services := &service.Service{AccessTokenService: accessToken}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/tokens/%s", test.tokenIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("tokenID")
+			ctx.SetParamValues(test.tokenIDStr)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.deleteAccessToken(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// @Summary Get all users
+// @Description List users, newest first, optionally filtered by a part of the email or username. Admin or support role
+// @Tags Admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param search query string false "Part of the email or username"
+// @Param pagination query model.Pagination false "Pagination options"
+// @Success 200 {object} resourceResponse
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Router /admin/users [get]
+func (h *Handler) getAllUsers(c echo.Context) error {
+	var pagination model.Pagination
+	if err := c.Bind(&pagination); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid url query")
+	}
+
+	users, err := h.AdminService.GetUsers(c.QueryParam("search"), &pagination)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(users),
+		Results:    users,
+		Pagination: &pagination,
+	})
+}
+
+// @Summary Get a user by ID
+// @Description Get any user by their ID. Admin or support role
+// @Tags Admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "User ID"
+// @Success 200 {object} model.User
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /admin/users/{userID} [get]
+func (h *Handler) getUserByID(c echo.Context) error {
+	userID, err := getValueFromParams(c, "userID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	user, err := h.AdminService.GetUser(userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// @Summary Set the role of a user
+// @Description Change the role of another user, who is signed out everywhere. Admin role
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "User ID"
+// @Param input body model.SetRoleDTO true "New role: user, support or admin"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /admin/users/{userID}/role [put]
+func (h *Handler) setUserRole(c echo.Context) error {
+	actorID := getContextUserID(c)
+
+	userID, err := getValueFromParams(c, "userID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input model.SetRoleDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	if err := h.AdminService.SetRole(actorID, userID, input); err != nil {
+		if err.Error() == "user not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Set the plan of a user
+// @Description Move a user to another plan. A smaller plan keeps what the user already has, but they cannot add
+// @Description more until they are under its quotas. Admin role
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "User ID"
+// @Param input body model.SetPlanDTO true "New plan: free, pro or team"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /admin/users/{userID}/plan [put]
+func (h *Handler) setUserPlan(c echo.Context) error {
+	userID, err := getValueFromParams(c, "userID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input model.SetPlanDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	if err := h.AdminService.SetPlan(userID, input); err != nil {
+		if err.Error() == "user not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Disable a user
+// @Description Block sign-ins of a user and revoke their sessions and personal access tokens. Admin role
+// @Tags Admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /admin/users/{userID}/disable [post]
+func (h *Handler) disableUser(c echo.Context) error {
+	actorID := getContextUserID(c)
+
+	userID, err := getValueFromParams(c, "userID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.AdminService.Disable(actorID, userID); err != nil {
+		if err.Error() == "user not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Enable a user
+// @Description Allow a disabled user to sign in again. Admin role
+// @Tags Admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /admin/users/{userID}/enable [post]
+func (h *Handler) enableUser(c echo.Context) error {
+	userID, err := getValueFromParams(c, "userID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.AdminService.Enable(userID); err != nil {
+		if err.Error() == "user not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Reset two-factor authentication
+// @Description Turn off two-factor authentication of a user who lost their authenticator and recovery codes.
+// @Description Admin or support role
+// @Tags Admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /admin/users/{userID}/2fa/reset [post]
+func (h *Handler) resetUserTwoFactor(c echo.Context) error {
+	userID, err := getValueFromParams(c, "userID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.AdminService.ResetTwoFactor(userID); err != nil {
+		if err.Error() == "user not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Get usage stats
+// @Description Counts of users, sessions, lists and items. Admin or support role
+// @Tags Admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} model.UsageStats
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /admin/stats [get]
+func (h *Handler) getUsageStats(c echo.Context) error {
+	stats, err := h.AdminService.GetStats()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+// @Summary Unlock sign-in
+// @Description Clear the failed sign-in counters and lockouts of an account email and/or an IP address.
+// @Description Admin or support role
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param input body model.UnlockSignInDTO true "Account email and/or IP address"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /admin/lockouts/unlock [post]
+func (h *Handler) unlockSignIn(c echo.Context) error {
+	var input model.UnlockSignInDTO
+
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	if input.Email != "" {
+		setAuditTarget(c, model.AuditTargetLogin, input.Email)
+	} else {
+		setAuditTarget(c, "ip", input.IP)
+	}
+
+	if err := h.LoginAttemptService.Unlock(input); err != nil {
+		if err.Error() == "email or ip is required" {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
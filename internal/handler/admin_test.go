@@ -0,0 +1,480 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_getAllUsers(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockAdminServicer)
+
+	tests := []struct {
+		name                string
+		query               string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:  "OK",
+			query: "?search=test&page=2&limit=1",
+			mockBehavior: func(s *mock_service.MockAdminServicer) {
+				s.EXPECT().GetUsers("test", &model.Pagination{Page: 2, Limit: 1}).Return([]model.User{
+					{
+						ID:        uuid.Nil,
+						Email:     "test@example.com",
+						Username:  "test",
+						Role:      model.RoleUser,
+						Plan:      model.PlanFree,
+						CreatedAt: time.Unix(0, 0).UTC(),
+					},
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"count":1,"results":[{"id":"00000000-0000-0000-0000-000000000000","email":"test@example.com","username":"test","verifiedAt":null,"role":"user","plan":"free","disabledAt":null,"createdAt":"1970-01-01T00:00:00Z"}],"pagination":{"page":2,"limit":1}}` + "\n",
+		},
+		{
+			name:  "Service Failure",
+			query: "",
+			mockBehavior: func(s *mock_service.MockAdminServicer) {
+				s.EXPECT().GetUsers("", &model.Pagination{}).Return(nil, errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			admin := mock_service.NewMockAdminServicer(c)
+			test.mockBehavior(admin)
+
+			services := &service.Service{AdminService: admin}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.GET("/admin/users", handler.getAllUsers)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/admin/users"+test.query, nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_setUserRole(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockAdminServicer, actorID, userID uuid.UUID, input model.SetRoleDTO)
+
+	tests := []struct {
+		name                string
+		userIDStr           string
+		inputBody           string
+		inputData           model.SetRoleDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			userIDStr: uuid.Nil.String(),
+			inputBody: `{"role": "support"}`,
+			inputData: model.SetRoleDTO{Role: model.RoleSupport},
+			mockBehavior: func(s *mock_service.MockAdminServicer, actorID, userID uuid.UUID, input model.SetRoleDTO) {
+				s.EXPECT().SetRole(actorID, userID, input).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			userIDStr:           "12312312",
+			inputBody:           `{"role": "support"}`,
+			mockBehavior:        func(s *mock_service.MockAdminServicer, actorID, userID uuid.UUID, input model.SetRoleDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:      "Invalid Role",
+			userIDStr: uuid.Nil.String(),
+			inputBody: `{"role": "root"}`,
+			inputData: model.SetRoleDTO{Role: "root"},
+			mockBehavior: func(s *mock_service.MockAdminServicer, actorID, userID uuid.UUID, input model.SetRoleDTO) {
+				s.EXPECT().SetRole(actorID, userID, input).Return(errors.New("role is not valid"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"role is not valid"}`,
+		},
+		{
+			name:      "Not Found",
+			userIDStr: uuid.Nil.String(),
+			inputBody: `{"role": "admin"}`,
+			inputData: model.SetRoleDTO{Role: model.RoleAdmin},
+			mockBehavior: func(s *mock_service.MockAdminServicer, actorID, userID uuid.UUID, input model.SetRoleDTO) {
+				s.EXPECT().SetRole(actorID, userID, input).Return(errors.New("user not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"user not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			actorID := uuid.New()
+
+			admin := mock_service.NewMockAdminServicer(c)
+			test.mockBehavior(admin, actorID, uuid.Nil, test.inputData)
+
+			services := &service.Service{AdminService: admin}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/admin/users/"+test.userIDStr+"/role", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("userID")
+			ctx.SetParamValues(test.userIDStr)
+
+			ctx.Set(ctxUserID, actorID.String())
+			err := handler.setUserRole(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_setUserPlan(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockAdminServicer, userID uuid.UUID, input model.SetPlanDTO)
+
+	tests := []struct {
+		name                string
+		userIDStr           string
+		inputBody           string
+		inputData           model.SetPlanDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			userIDStr: uuid.Nil.String(),
+			inputBody: `{"plan": "pro"}`,
+			inputData: model.SetPlanDTO{Plan: model.PlanPro},
+			mockBehavior: func(s *mock_service.MockAdminServicer, userID uuid.UUID, input model.SetPlanDTO) {
+				s.EXPECT().SetPlan(userID, input).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			userIDStr:           "12312312",
+			inputBody:           `{"plan": "pro"}`,
+			mockBehavior:        func(s *mock_service.MockAdminServicer, userID uuid.UUID, input model.SetPlanDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:      "Invalid Plan",
+			userIDStr: uuid.Nil.String(),
+			inputBody: `{"plan": "enterprise"}`,
+			inputData: model.SetPlanDTO{Plan: "enterprise"},
+			mockBehavior: func(s *mock_service.MockAdminServicer, userID uuid.UUID, input model.SetPlanDTO) {
+				s.EXPECT().SetPlan(userID, input).Return(errors.New("plan is not valid"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"plan is not valid"}`,
+		},
+		{
+			name:      "Not Found",
+			userIDStr: uuid.Nil.String(),
+			inputBody: `{"plan": "team"}`,
+			inputData: model.SetPlanDTO{Plan: model.PlanTeam},
+			mockBehavior: func(s *mock_service.MockAdminServicer, userID uuid.UUID, input model.SetPlanDTO) {
+				s.EXPECT().SetPlan(userID, input).Return(errors.New("user not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"user not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			admin := mock_service.NewMockAdminServicer(c)
+			test.mockBehavior(admin, uuid.Nil, test.inputData)
+
+			services := &service.Service{AdminService: admin}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/admin/users/"+test.userIDStr+"/plan", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("userID")
+			ctx.SetParamValues(test.userIDStr)
+
+			ctx.Set(ctxUserID, uuid.New().String())
+			err := handler.setUserPlan(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_disableUser(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockAdminServicer, actorID, userID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockAdminServicer, actorID, userID uuid.UUID) {
+				s.EXPECT().Disable(actorID, userID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name: "Already Disabled",
+			mockBehavior: func(s *mock_service.MockAdminServicer, actorID, userID uuid.UUID) {
+				s.EXPECT().Disable(actorID, userID).Return(errors.New("account is already disabled"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"account is already disabled"}`,
+		},
+		{
+			name: "Not Found",
+			mockBehavior: func(s *mock_service.MockAdminServicer, actorID, userID uuid.UUID) {
+				s.EXPECT().Disable(actorID, userID).Return(errors.New("user not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"user not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			actorID := uuid.New()
+			userID := uuid.New()
+
+			admin := mock_service.NewMockAdminServicer(c)
+			test.mockBehavior(admin, actorID, userID)
+
+			services := &service.Service{AdminService: admin}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/users/"+userID.String()+"/disable", nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("userID")
+			ctx.SetParamValues(userID.String())
+
+			ctx.Set(ctxUserID, actorID.String())
+			err := handler.disableUser(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_getUsageStats(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockAdminServicer)
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockAdminServicer) {
+				s.EXPECT().GetStats().Return(model.UsageStats{Users: 3, VerifiedUsers: 2, Lists: 4, Items: 10}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"users":3,"verifiedUsers":2,"disabledUsers":0,"twoFactorUsers":0,"newUsersLast30Days":0,"activeSessions":0,"lists":4,"items":10,"completedItems":0}` + "\n",
+		},
+		{
+			name: "Service Failure",
+			mockBehavior: func(s *mock_service.MockAdminServicer) {
+				s.EXPECT().GetStats().Return(model.UsageStats{}, errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			admin := mock_service.NewMockAdminServicer(c)
+			test.mockBehavior(admin)
+
+			services := &service.Service{AdminService: admin}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.GET("/admin/stats", handler.getUsageStats)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/admin/stats", nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_unlockSignIn(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockLoginAttemptServicer, input model.UnlockSignInDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.UnlockSignInDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"email": "test@example.com", "ip": "192.0.2.1"}`,
+			inputData: model.UnlockSignInDTO{Email: "test@example.com", IP: "192.0.2.1"},
+			mockBehavior: func(s *mock_service.MockLoginAttemptServicer, input model.UnlockSignInDTO) {
+				s.EXPECT().Unlock(input).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockLoginAttemptServicer, input model.UnlockSignInDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}` + "\n",
+		},
+		{
+			name:      "Missing Email And IP",
+			inputBody: `{}`,
+			mockBehavior: func(s *mock_service.MockLoginAttemptServicer, input model.UnlockSignInDTO) {
+				s.EXPECT().Unlock(input).Return(errors.New("email or ip is required"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"email or ip is required"}` + "\n",
+		},
+		{
+			name:      "Nothing To Unlock",
+			inputBody: `{"ip": "192.0.2.1"}`,
+			inputData: model.UnlockSignInDTO{IP: "192.0.2.1"},
+			mockBehavior: func(s *mock_service.MockLoginAttemptServicer, input model.UnlockSignInDTO) {
+				s.EXPECT().Unlock(input).Return(errors.New("no failed sign-ins recorded"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"no failed sign-ins recorded"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			loginAttempts := mock_service.NewMockLoginAttemptServicer(c)
+			test.mockBehavior(loginAttempts, test.inputData)
+
+			services := &service.Service{LoginAttemptService: loginAttempts}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.POST("/admin/lockouts/unlock", handler.unlockSignIn)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/lockouts/unlock", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+type auditTarget struct {
+	Type string
+	ID   string
+}
+
+// setAuditTarget names what the request acted on, e.g. the login of a sign-in.
+func setAuditTarget(c echo.Context, targetType, targetID string) {
+	c.Set(ctxAuditTarget, auditTarget{Type: targetType, ID: targetID})
+}
+
+// setAuditUser names the account the request concerns when the request is not authenticated.
+func setAuditUser(c echo.Context, userID uuid.UUID) {
+	c.Set(ctxAuditUserID, userID)
+}
+
+func newAuditEvent(c echo.Context, action string, err error) model.AuditEvent {
+	metadata := getSessionMetadata(c)
+
+	event := model.AuditEvent{
+		Action:    action,
+		IP:        metadata.IP,
+		UserAgent: metadata.UserAgent,
+		Outcome:   model.AuditOutcomeSuccess,
+	}
+
+	if value, ok := c.Get(ctxUserID).(string); ok {
+		if actorID, err := uuid.Parse(value); err == nil {
+			event.ActorID = &actorID
+		}
+	}
+
+	if userID, ok := c.Get(ctxAuditUserID).(uuid.UUID); ok {
+		event.UserID = &userID
+	}
+
+	if target, ok := c.Get(ctxAuditTarget).(auditTarget); ok {
+		event.TargetType = target.Type
+		event.TargetID = target.ID
+	} else if names := c.ParamNames(); len(names) > 0 {
+		name := names[len(names)-1]
+		event.TargetType = strings.TrimSuffix(name, "ID")
+		event.TargetID = c.Param(name)
+	}
+
+	if err != nil {
+		event.Outcome = model.AuditOutcomeFailure
+		event.Reason = auditReason(err)
+	} else if c.Response().Status >= http.StatusBadRequest {
+		event.Outcome = model.AuditOutcomeFailure
+	}
+
+	return event
+}
+
+func auditReason(err error) string {
+	var httpErr *echo.HTTPError
+	if !errors.As(err, &httpErr) {
+		return err.Error()
+	}
+
+	switch message := httpErr.Message.(type) {
+	case string:
+		return message
+	case passwordPolicyResponse:
+		return message.Message
+	default:
+		return http.StatusText(httpErr.Code)
+	}
+}
+
+// @Summary Get my audit events
+// @Description Sign-ins, failed sign-ins and changes concerning the current user's account, newest first
+// @Tags Profile
+// @Produce json
+// @Security ApiKeyAuth
+// @Param pagination query model.Pagination false "Pagination options"
+// @Success 200 {object} resourceResponse
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/me/audit [get]
+func (h *Handler) getMyAuditEvents(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	var pagination model.Pagination
+	if err := c.Bind(&pagination); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid url query")
+	}
+
+	events, err := h.AuditService.GetForUser(userID, &pagination)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(events),
+		Results:    events,
+		Pagination: &pagination,
+	})
+}
+
+// @Summary Query audit events
+// @Description Audit events of all users, newest first, filtered by actor, account, action, outcome and time.
+// @Description Admin or support role
+// @Tags Admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param filter query model.AuditFilterDTO false "Filters"
+// @Param pagination query model.Pagination false "Pagination options"
+// @Success 200 {object} resourceResponse
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Router /admin/audit [get]
+func (h *Handler) getAuditEvents(c echo.Context) error {
+	var pagination model.Pagination
+	if err := c.Bind(&pagination); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid url query")
+	}
+
+	var filter model.AuditFilterDTO
+	if err := c.Bind(&filter); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid url query")
+	}
+
+	events, err := h.AuditService.Find(filter, &pagination)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(events),
+		Results:    events,
+		Pagination: &pagination,
+	})
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_Audit(t *testing.T) {
+	actorID := uuid.New()
+	listID := uuid.New()
+
+	tests := []struct {
+		name               string
+		handler            echo.HandlerFunc
+		expectedEvent      model.AuditEvent
+		recordErr          error
+		expectedStatusCode int
+	}{
+		{
+			name: "Success",
+			handler: func(c echo.Context) error {
+				return c.NoContent(http.StatusNoContent)
+			},
+			expectedEvent: model.AuditEvent{
+				ActorID:    &actorID,
+				Action:     model.AuditListDelete,
+				TargetType: "list",
+				TargetID:   listID.String(),
+				IP:         testSessionMetadata.IP,
+				UserAgent:  "test-agent",
+				Outcome:    model.AuditOutcomeSuccess,
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Failure",
+			handler: func(c echo.Context) error {
+				return echo.NewHTTPError(http.StatusNotFound, "list not found")
+			},
+			expectedEvent: model.AuditEvent{
+				ActorID:    &actorID,
+				Action:     model.AuditListDelete,
+				TargetType: "list",
+				TargetID:   listID.String(),
+				IP:         testSessionMetadata.IP,
+				UserAgent:  "test-agent",
+				Outcome:    model.AuditOutcomeFailure,
+				Reason:     "list not found",
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Target Set By Handler",
+			handler: func(c echo.Context) error {
+				setAuditTarget(c, model.AuditTargetLogin, "test")
+				return c.NoContent(http.StatusNoContent)
+			},
+			expectedEvent: model.AuditEvent{
+				ActorID:    &actorID,
+				Action:     model.AuditListDelete,
+				TargetType: model.AuditTargetLogin,
+				TargetID:   "test",
+				IP:         testSessionMetadata.IP,
+				UserAgent:  "test-agent",
+				Outcome:    model.AuditOutcomeSuccess,
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Record Failure",
+			handler: func(c echo.Context) error {
+				return c.NoContent(http.StatusNoContent)
+			},
+			expectedEvent: model.AuditEvent{
+				ActorID:    &actorID,
+				Action:     model.AuditListDelete,
+				TargetType: "list",
+				TargetID:   listID.String(),
+				IP:         testSessionMetadata.IP,
+				UserAgent:  "test-agent",
+				Outcome:    model.AuditOutcomeSuccess,
+			},
+			recordErr:          errors.New("service failure"),
+			expectedStatusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			audit := mock_service.NewMockAuditServicer(c)
+			audit.EXPECT().Record(test.expectedEvent).Return(test.recordErr)
+
+			services := &service.Service{AuditService: audit}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.DELETE("/lists/:listID", test.handler, func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					c.Set(ctxUserID, actorID.String())
+					return next(c)
+				}
+			}, handler.Audit(model.AuditListDelete))
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/lists/"+listID.String(), nil)
+			req.RemoteAddr = testSessionMetadata.IP + ":1234"
+			req.Header.Set("User-Agent", "test-agent")
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestHandler_getAuditEvents(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockAuditServicer)
+
+	tests := []struct {
+		name                string
+		query               string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:  "OK",
+			query: "?action=auth.sign_in&outcome=failure&page=1&limit=1",
+			mockBehavior: func(s *mock_service.MockAuditServicer) {
+				s.EXPECT().Find(model.AuditFilterDTO{Action: model.AuditSignIn, Outcome: model.AuditOutcomeFailure},
+					&model.Pagination{Page: 1, Limit: 1}).Return([]model.AuditEvent{
+					{
+						ID:         uuid.Nil,
+						Action:     model.AuditSignIn,
+						TargetType: model.AuditTargetLogin,
+						TargetID:   "test",
+						IP:         "192.0.2.1",
+						Outcome:    model.AuditOutcomeFailure,
+						Reason:     "wrong credentials",
+						CreatedAt:  time.Unix(0, 0).UTC(),
+					},
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"count":1,"results":[{"id":"00000000-0000-0000-0000-000000000000","actorId":null,"userId":null,"action":"auth.sign_in","targetType":"login","targetId":"test","ip":"192.0.2.1","userAgent":"","outcome":"failure","reason":"wrong credentials","createdAt":"1970-01-01T00:00:00Z"}],"pagination":{"page":1,"limit":1}}` + "\n",
+		},
+		{
+			name:  "Invalid Filter",
+			query: "?outcome=maybe",
+			mockBehavior: func(s *mock_service.MockAuditServicer) {
+				s.EXPECT().Find(model.AuditFilterDTO{Outcome: "maybe"}, &model.Pagination{}).
+					Return(nil, errors.New("outcome must be success or failure"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"outcome must be success or failure"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			audit := mock_service.NewMockAuditServicer(c)
+			test.mockBehavior(audit)
+
+			services := &service.Service{AuditService: audit}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.GET("/admin/audit", handler.getAuditEvents)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/admin/audit"+test.query, nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
@@ -1,29 +1,44 @@
 package handler
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rtsoy/todo-app/internal/model"
 )
 
 type signInInput struct {
+	// Login is the email address or the username, in any case
+	Login string `json:"login"`
+	// Deprecated: use Login, kept for clients that still send the email field
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
 type signInResponse struct {
-	Token string `json:"token"`
+	Token          string `json:"token,omitempty"`
+	RefreshToken   string `json:"refreshToken,omitempty"`
+	ChallengeToken string `json:"challengeToken,omitempty"`
+}
+
+type refreshTokenInput struct {
+	RefreshToken string `json:"refreshToken"`
 }
 
 // @Summary Sign In
-// @Description Authenticate user using email and password
+// @Description Authenticate user using the email or username as login and the password. Users with
+// @Description two-factor authentication get a challengeToken instead of tokens and have to continue
+// @Description with /auth/sign-in/2fa
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param input body signInInput true "Authentication data"
 // @Success 200 {object} signInResponse
 // @Failure 400 {object} swaggerErrorResponse
+// @Failure 429 {object} swaggerErrorResponse
 // @Router /auth/sign-in [post]
 func (h *Handler) signIn(c echo.Context) error {
 	var input signInInput
@@ -32,22 +47,142 @@ func (h *Handler) signIn(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
 	}
 
-	token, err := h.UserService.GenerateToken(input.Email, input.Password)
+	login := input.Login
+	if login == "" {
+		login = input.Email
+	}
+
+	setAuditTarget(c, model.AuditTargetLogin, login)
+
+	tokens, err := h.UserService.GenerateToken(login, input.Password, getSessionMetadata(c))
 	if err != nil {
+		var lockedOutErr *model.LockedOutError
+		if errors.As(err, &lockedOutErr) {
+			return lockedOut(c, lockedOutErr)
+		}
+
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	return c.JSON(http.StatusOK, signInResponse{Token: token})
+	setAuditUser(c, tokens.UserID)
+
+	return c.JSON(http.StatusOK, newSignInResponse(tokens))
+}
+
+// lockedOut rejects a sign-in while it is locked and tells the client when to retry.
+func lockedOut(c echo.Context, err *model.LockedOutError) error {
+	retryAfter := int(math.Ceil(err.RetryAfter.Seconds()))
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(retryAfter))
+
+	return echo.NewHTTPError(http.StatusTooManyRequests, err.Error())
+}
+
+// passwordError answers a password policy failure with 400 and the rules that failed,
+// any other error with the given status.
+func passwordError(err error, code int) error {
+	var policyErr *model.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		return echo.NewHTTPError(http.StatusBadRequest, passwordPolicyResponse{
+			Message:    "password is not valid",
+			Violations: policyErr.Violations,
+		})
+	}
+
+	return echo.NewHTTPError(code, err.Error())
+}
+
+// @Summary Refresh
+// @Description Exchange a refresh token for a new access token and a rotated refresh token
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param input body refreshTokenInput true "Refresh token"
+// @Success 200 {object} signInResponse
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 401 {object} swaggerErrorResponse
+// @Router /auth/refresh [post]
+func (h *Handler) refresh(c echo.Context) error {
+	var input refreshTokenInput
+
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	tokens, err := h.SessionService.Refresh(input.RefreshToken, getSessionMetadata(c))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, newSignInResponse(tokens))
+}
+
+// @Summary Log Out
+// @Description Revoke the session the refresh token belongs to
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param input body refreshTokenInput true "Refresh token"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 401 {object} swaggerErrorResponse
+// @Router /auth/logout [post]
+func (h *Handler) logout(c echo.Context) error {
+	var input refreshTokenInput
+
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	if err := h.SessionService.Revoke(input.RefreshToken); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Sign In With Two-Factor Code
+// @Description Complete a sign-in with the challenge token and a TOTP or recovery code
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param input body model.TwoFactorSignInDTO true "Challenge token and code"
+// @Success 200 {object} signInResponse
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 401 {object} swaggerErrorResponse
+// @Failure 429 {object} swaggerErrorResponse
+// @Router /auth/sign-in/2fa [post]
+func (h *Handler) signInTwoFactor(c echo.Context) error {
+	var input model.TwoFactorSignInDTO
+
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	tokens, err := h.TwoFactorService.SignIn(input, getSessionMetadata(c))
+	if err != nil {
+		var lockedOutErr *model.LockedOutError
+		if errors.As(err, &lockedOutErr) {
+			return lockedOut(c, lockedOutErr)
+		}
+
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	setAuditUser(c, tokens.UserID)
+
+	return c.JSON(http.StatusOK, newSignInResponse(tokens))
 }
 
 // @Summary Sign Up
-// @Description Register a new user
+// @Description Register a new user. Depending on the registration mode an invitation code or an address
+// @Description in an allowed domain is required
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param input body model.CreateUserDTO true "Registration data"
 // @Success 200 {object} createResponse
-// @Failure 400 {object} swaggerErrorResponse
+// @Failure 400 {object} passwordPolicyResponse
+// @Failure 403 {object} swaggerErrorResponse
 // @Failure 409 {object} swaggerErrorResponse
 // @Router /auth/sign-up [post]
 func (h *Handler) signUp(c echo.Context) error {
@@ -57,10 +192,127 @@ func (h *Handler) signUp(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
 	}
 
+	setAuditTarget(c, model.AuditTargetLogin, input.Email)
+
 	id, err := h.UserService.CreateUser(input)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusConflict, err.Error())
+		switch err.Error() {
+		case "registration is disabled", "registration is limited to allowed email domains",
+			"an invitation code is required", "invalid or expired invitation code":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+
+		return passwordError(err, http.StatusConflict)
 	}
 
+	setAuditTarget(c, model.AuditTargetUser, id.String())
+
 	return c.JSON(http.StatusOK, createResponse{ID: id.String()})
 }
+
+// @Summary Forgot Password
+// @Description Email a password reset link to the given address if it belongs to an account
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param input body model.ForgotPasswordDTO true "Account email"
+// @Success 202 "Accepted"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /auth/password/forgot [post]
+func (h *Handler) forgotPassword(c echo.Context) error {
+	var input model.ForgotPasswordDTO
+
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	setAuditTarget(c, model.AuditTargetLogin, input.Email)
+
+	if err := h.PasswordResetService.RequestReset(input); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+// @Summary Reset Password
+// @Description Set a new password using a token from the reset email
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param input body model.ResetPasswordDTO true "Reset token and new password"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Router /auth/password/reset [post]
+func (h *Handler) resetPassword(c echo.Context) error {
+	var input model.ResetPasswordDTO
+
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	userID, err := h.PasswordResetService.Reset(input)
+	if err != nil {
+		return passwordError(err, http.StatusBadRequest)
+	}
+
+	setAuditUser(c, userID)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Verify Email
+// @Description Confirm an email address using the token from the verification email
+// @Tags Auth
+// @Produce json
+// @Param token query string true "Verification token"
+// @Success 200 {object} swaggerMessageResponse
+// @Failure 400 {object} swaggerErrorResponse
+// @Router /auth/verify [get]
+func (h *Handler) verifyEmail(c echo.Context) error {
+	token := c.QueryParam("token")
+	if token == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "no token provided")
+	}
+
+	if err := h.EmailVerificationService.Verify(token); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"message": "email address verified",
+	})
+}
+
+// @Summary Resend Verification Email
+// @Description Send a new verification email if the address belongs to an unverified account
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param input body model.ResendVerificationDTO true "Account email"
+// @Success 202 "Accepted"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /auth/verify/resend [post]
+func (h *Handler) resendVerification(c echo.Context) error {
+	var input model.ResendVerificationDTO
+
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	if err := h.EmailVerificationService.Resend(input); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+func newSignInResponse(tokens model.Tokens) signInResponse {
+	return signInResponse{
+		Token:          tokens.AccessToken,
+		RefreshToken:   tokens.RefreshToken,
+		ChallengeToken: tokens.ChallengeToken,
+	}
+}
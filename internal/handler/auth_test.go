@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -16,6 +17,9 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// Metadata echo derives from a request built by httptest.NewRequest
+var testSessionMetadata = model.SessionMetadata{IP: "192.0.2.1"}
+
 func TestHandler_signIn(t *testing.T) {
 	type mockBehavior func(s *mock_service.MockUserServicer, input signInInput)
 
@@ -26,19 +30,70 @@ func TestHandler_signIn(t *testing.T) {
 		mockBehavior        mockBehavior
 		expectedStatusCode  int
 		expectedRequestBody string
+		expectedRetryAfter  string
 	}{
 		{
 			name:      "OK",
+			inputBody: `{"login": "test@example.com", "password": "qwerty123"}`,
+			inputData: signInInput{
+				Login:    "test@example.com",
+				Password: "qwerty123",
+			},
+			mockBehavior: func(s *mock_service.MockUserServicer, input signInInput) {
+				s.EXPECT().GenerateToken(input.Login, input.Password, testSessionMetadata).Return(model.Tokens{
+					AccessToken:  "test-token",
+					RefreshToken: "test-refresh-token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"token":"test-token","refreshToken":"test-refresh-token"}`,
+		},
+		{
+			name:      "Two-Factor Challenge",
+			inputBody: `{"login": "test@example.com", "password": "qwerty123"}`,
+			inputData: signInInput{
+				Login:    "test@example.com",
+				Password: "qwerty123",
+			},
+			mockBehavior: func(s *mock_service.MockUserServicer, input signInInput) {
+				s.EXPECT().GenerateToken(input.Login, input.Password, testSessionMetadata).Return(model.Tokens{
+					ChallengeToken: "challenge-token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"challengeToken":"challenge-token"}`,
+		},
+		{
+			name:      "Username",
+			inputBody: `{"login": "Test", "password": "qwerty123"}`,
+			inputData: signInInput{
+				Login:    "Test",
+				Password: "qwerty123",
+			},
+			mockBehavior: func(s *mock_service.MockUserServicer, input signInInput) {
+				s.EXPECT().GenerateToken(input.Login, input.Password, testSessionMetadata).Return(model.Tokens{
+					AccessToken:  "test-token",
+					RefreshToken: "test-refresh-token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"token":"test-token","refreshToken":"test-refresh-token"}`,
+		},
+		{
+			name:      "Legacy Email Field",
 			inputBody: `{"email": "test@example.com", "password": "qwerty123"}`,
 			inputData: signInInput{
-				Email:    "test@example.com",
+				Login:    "test@example.com",
 				Password: "qwerty123",
 			},
 			mockBehavior: func(s *mock_service.MockUserServicer, input signInInput) {
-				s.EXPECT().GenerateToken(input.Email, input.Password).Return("test-token", nil)
+				s.EXPECT().GenerateToken(input.Login, input.Password, testSessionMetadata).Return(model.Tokens{
+					AccessToken:  "test-token",
+					RefreshToken: "test-refresh-token",
+				}, nil)
 			},
 			expectedStatusCode:  http.StatusOK,
-			expectedRequestBody: `{"token":"test-token"}`,
+			expectedRequestBody: `{"token":"test-token","refreshToken":"test-refresh-token"}`,
 		},
 		{
 			name:                "Invalid JSON",
@@ -49,17 +104,32 @@ func TestHandler_signIn(t *testing.T) {
 		},
 		{
 			name:      "Service failure",
-			inputBody: `{"email": "test@example.com", "username": "test", "password": "qwerty123"}`,
+			inputBody: `{"login": "test@example.com", "password": "qwerty123"}`,
 			inputData: signInInput{
-				Email:    "test@example.com",
+				Login:    "test@example.com",
 				Password: "qwerty123",
 			},
 			mockBehavior: func(s *mock_service.MockUserServicer, input signInInput) {
-				s.EXPECT().GenerateToken(input.Email, input.Password).Return("", errors.New("service failure"))
+				s.EXPECT().GenerateToken(input.Login, input.Password, testSessionMetadata).Return(model.Tokens{}, errors.New("service failure"))
 			},
 			expectedStatusCode:  http.StatusBadRequest,
 			expectedRequestBody: `{"message":"service failure"}`,
 		},
+		{
+			name:      "Locked Out",
+			inputBody: `{"login": "test@example.com", "password": "qwerty123"}`,
+			inputData: signInInput{
+				Login:    "test@example.com",
+				Password: "qwerty123",
+			},
+			mockBehavior: func(s *mock_service.MockUserServicer, input signInInput) {
+				s.EXPECT().GenerateToken(input.Login, input.Password, testSessionMetadata).Return(model.Tokens{},
+					&model.LockedOutError{RetryAfter: 90*time.Second + time.Millisecond})
+			},
+			expectedStatusCode:  http.StatusTooManyRequests,
+			expectedRequestBody: `{"message":"too many failed sign-in attempts, try again later"}`,
+			expectedRetryAfter:  "91",
+		},
 	}
 
 	for _, test := range tests {
@@ -84,6 +154,90 @@ func TestHandler_signIn(t *testing.T) {
 
 			assert.Equal(t, test.expectedStatusCode, w.Code)
 			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+			assert.Equal(t, test.expectedRetryAfter, w.Header().Get(echo.HeaderRetryAfter))
+		})
+	}
+}
+
+func TestHandler_signInTwoFactor(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTwoFactorServicer, input model.TwoFactorSignInDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.TwoFactorSignInDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+		expectedRetryAfter  string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"challengeToken": "challenge-token", "code": "123456"}`,
+			inputData: model.TwoFactorSignInDTO{ChallengeToken: "challenge-token", Code: "123456"},
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, input model.TwoFactorSignInDTO) {
+				s.EXPECT().SignIn(input, testSessionMetadata).Return(model.Tokens{
+					AccessToken:  "test-token",
+					RefreshToken: "test-refresh-token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"token":"test-token","refreshToken":"test-refresh-token"}`,
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockTwoFactorServicer, input model.TwoFactorSignInDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "Invalid Code",
+			inputBody: `{"challengeToken": "challenge-token", "code": "000000"}`,
+			inputData: model.TwoFactorSignInDTO{ChallengeToken: "challenge-token", Code: "000000"},
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, input model.TwoFactorSignInDTO) {
+				s.EXPECT().SignIn(input, testSessionMetadata).Return(model.Tokens{}, errors.New("invalid two-factor code"))
+			},
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedRequestBody: `{"message":"invalid two-factor code"}`,
+		},
+		{
+			name:      "Locked Out",
+			inputBody: `{"challengeToken": "challenge-token", "code": "000000"}`,
+			inputData: model.TwoFactorSignInDTO{ChallengeToken: "challenge-token", Code: "000000"},
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, input model.TwoFactorSignInDTO) {
+				s.EXPECT().SignIn(input, testSessionMetadata).Return(model.Tokens{},
+					&model.LockedOutError{RetryAfter: time.Minute})
+			},
+			expectedStatusCode:  http.StatusTooManyRequests,
+			expectedRequestBody: `{"message":"too many failed sign-in attempts, try again later"}`,
+			expectedRetryAfter:  "60",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			twoFactor := mock_service.NewMockTwoFactorServicer(c)
+			test.mockBehavior(twoFactor, test.inputData)
+
+			services := &service.Service{TwoFactorService: twoFactor}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.POST("/sign-in/2fa", handler.signInTwoFactor)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/sign-in/2fa", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+			assert.Equal(t, test.expectedRetryAfter, w.Header().Get(echo.HeaderRetryAfter))
 		})
 	}
 }
@@ -134,6 +288,41 @@ func TestHandler_signUp(t *testing.T) {
 			expectedStatusCode:  http.StatusConflict,
 			expectedRequestBody: `{"message":"service failure"}`,
 		},
+		{
+			name:      "Invitation required",
+			inputBody: `{"email": "test@example.com", "username": "test", "password": "qwerty123"}`,
+			inputUser: model.CreateUserDTO{
+				Email:    "test@example.com",
+				Username: "test",
+				Password: "qwerty123",
+			},
+			mockBehavior: func(s *mock_service.MockUserServicer, user model.CreateUserDTO) {
+				s.EXPECT().CreateUser(user).Return(uuid.Nil, errors.New("an invitation code is required"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"an invitation code is required"}`,
+		},
+		{
+			name:      "Weak password",
+			inputBody: `{"email": "test@example.com", "username": "test", "password": "qwerty"}`,
+			inputUser: model.CreateUserDTO{
+				Email:    "test@example.com",
+				Username: "test",
+				Password: "qwerty",
+			},
+			mockBehavior: func(s *mock_service.MockUserServicer, user model.CreateUserDTO) {
+				s.EXPECT().CreateUser(user).Return(uuid.Nil, &model.PasswordPolicyError{
+					Violations: []model.PasswordViolation{
+						{Rule: model.PasswordRuleMinLength, Message: "password must be at least 10 characters long"},
+						{Rule: model.PasswordRuleBreached, Message: "password has appeared in a data breach, choose a different one"},
+					},
+				})
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedRequestBody: `{"message":"password is not valid","violations":[` +
+				`{"rule":"min_length","message":"password must be at least 10 characters long"},` +
+				`{"rule":"breached","message":"password has appeared in a data breach, choose a different one"}]}`,
+		},
 	}
 
 	for _, test := range tests {
@@ -161,3 +350,348 @@ func TestHandler_signUp(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_refresh(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockSessionServicer, refreshToken string)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		refreshToken        string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:         "OK",
+			inputBody:    `{"refreshToken": "old-refresh-token"}`,
+			refreshToken: "old-refresh-token",
+			mockBehavior: func(s *mock_service.MockSessionServicer, refreshToken string) {
+				s.EXPECT().Refresh(refreshToken, testSessionMetadata).Return(model.Tokens{
+					AccessToken:  "test-token",
+					RefreshToken: "new-refresh-token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"token":"test-token","refreshToken":"new-refresh-token"}`,
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockSessionServicer, refreshToken string) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:         "Service failure",
+			inputBody:    `{"refreshToken": "old-refresh-token"}`,
+			refreshToken: "old-refresh-token",
+			mockBehavior: func(s *mock_service.MockSessionServicer, refreshToken string) {
+				s.EXPECT().Refresh(refreshToken, testSessionMetadata).Return(model.Tokens{}, errors.New("session has been revoked"))
+			},
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedRequestBody: `{"message":"session has been revoked"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			session := mock_service.NewMockSessionServicer(c)
+			test.mockBehavior(session, test.refreshToken)
+
+			services := &service.Service{SessionService: session}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.POST("/refresh", handler.refresh)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/refresh", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+		})
+	}
+}
+
+func TestHandler_logout(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockSessionServicer, refreshToken string)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		refreshToken        string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:         "OK",
+			inputBody:    `{"refreshToken": "refresh-token"}`,
+			refreshToken: "refresh-token",
+			mockBehavior: func(s *mock_service.MockSessionServicer, refreshToken string) {
+				s.EXPECT().Revoke(refreshToken).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockSessionServicer, refreshToken string) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}` + "\n",
+		},
+		{
+			name:         "Service failure",
+			inputBody:    `{"refreshToken": "refresh-token"}`,
+			refreshToken: "refresh-token",
+			mockBehavior: func(s *mock_service.MockSessionServicer, refreshToken string) {
+				s.EXPECT().Revoke(refreshToken).Return(errors.New("invalid refresh token"))
+			},
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedRequestBody: `{"message":"invalid refresh token"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			session := mock_service.NewMockSessionServicer(c)
+			test.mockBehavior(session, test.refreshToken)
+
+			services := &service.Service{SessionService: session}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.POST("/logout", handler.logout)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/logout", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_forgotPassword(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockPasswordResetServicer, input model.ForgotPasswordDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.ForgotPasswordDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"email": "test@example.com"}`,
+			inputData: model.ForgotPasswordDTO{Email: "test@example.com"},
+			mockBehavior: func(s *mock_service.MockPasswordResetServicer, input model.ForgotPasswordDTO) {
+				s.EXPECT().RequestReset(input).Return(nil)
+			},
+			expectedStatusCode:  http.StatusAccepted,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockPasswordResetServicer, input model.ForgotPasswordDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: `{"email": "test@example.com"}`,
+			inputData: model.ForgotPasswordDTO{Email: "test@example.com"},
+			mockBehavior: func(s *mock_service.MockPasswordResetServicer, input model.ForgotPasswordDTO) {
+				s.EXPECT().RequestReset(input).Return(errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			passwordReset := mock_service.NewMockPasswordResetServicer(c)
+			test.mockBehavior(passwordReset, test.inputData)
+
+			services := &service.Service{PasswordResetService: passwordReset}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.POST("/password/forgot", handler.forgotPassword)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/password/forgot", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_resetPassword(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockPasswordResetServicer, input model.ResetPasswordDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.ResetPasswordDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"token": "reset-token", "password": "qwerty123"}`,
+			inputData: model.ResetPasswordDTO{Token: "reset-token", Password: "qwerty123"},
+			mockBehavior: func(s *mock_service.MockPasswordResetServicer, input model.ResetPasswordDTO) {
+				s.EXPECT().Reset(input).Return(uuid.Nil, nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockPasswordResetServicer, input model.ResetPasswordDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}` + "\n",
+		},
+		{
+			name:      "Service failure",
+			inputBody: `{"token": "reset-token", "password": "qwerty123"}`,
+			inputData: model.ResetPasswordDTO{Token: "reset-token", Password: "qwerty123"},
+			mockBehavior: func(s *mock_service.MockPasswordResetServicer, input model.ResetPasswordDTO) {
+				s.EXPECT().Reset(input).Return(uuid.Nil, errors.New("invalid or expired reset token"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid or expired reset token"}` + "\n",
+		},
+		{
+			name:      "Weak password",
+			inputBody: `{"token": "reset-token", "password": "aaaaaaaaaa"}`,
+			inputData: model.ResetPasswordDTO{Token: "reset-token", Password: "aaaaaaaaaa"},
+			mockBehavior: func(s *mock_service.MockPasswordResetServicer, input model.ResetPasswordDTO) {
+				s.EXPECT().Reset(input).Return(uuid.Nil, &model.PasswordPolicyError{
+					Violations: []model.PasswordViolation{
+						{Rule: model.PasswordRuleCharacterClasses, Message: "password must contain at least 2 of: " +
+							"lowercase letters, uppercase letters, digits, symbols"},
+					},
+				})
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedRequestBody: `{"message":"password is not valid","violations":[{"rule":"character_classes",` +
+				`"message":"password must contain at least 2 of: lowercase letters, uppercase letters, digits, symbols"}]}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			passwordReset := mock_service.NewMockPasswordResetServicer(c)
+			test.mockBehavior(passwordReset, test.inputData)
+
+			services := &service.Service{PasswordResetService: passwordReset}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.POST("/password/reset", handler.resetPassword)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_verifyEmail(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockEmailVerificationServicer, token string)
+
+	tests := []struct {
+		name                string
+		token               string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:  "OK",
+			token: "verification-token",
+			mockBehavior: func(s *mock_service.MockEmailVerificationServicer, token string) {
+				s.EXPECT().Verify(token).Return(nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"message":"email address verified"}`,
+		},
+		{
+			name:                "No Token",
+			token:               "",
+			mockBehavior:        func(s *mock_service.MockEmailVerificationServicer, token string) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"no token provided"}`,
+		},
+		{
+			name:  "Service failure",
+			token: "verification-token",
+			mockBehavior: func(s *mock_service.MockEmailVerificationServicer, token string) {
+				s.EXPECT().Verify(token).Return(errors.New("invalid or expired verification token"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid or expired verification token"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			emailVerification := mock_service.NewMockEmailVerificationServicer(c)
+			test.mockBehavior(emailVerification, test.token)
+
+			services := &service.Service{EmailVerificationService: emailVerification}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.GET("/verify", handler.verifyEmail)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/verify?token="+test.token, nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary Request data export
+// @Description Start building a zip archive with the profile and all lists and items as JSON and CSV.
+// @Description Poll the export until it is ready to get the download link
+// @Tags Profile
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 202 {object} model.DataExport
+// @Failure 409 {object} swaggerErrorResponse
+// @Router /api/me/export [post]
+func (h *Handler) createDataExport(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	export, err := h.DataExportService.Create(userID)
+	if err != nil {
+		if err.Error() == "an export is already in progress" {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	setAuditTarget(c, "export", export.ID.String())
+
+	return c.JSON(http.StatusAccepted, export)
+}
+
+// @Summary Get data export
+// @Description Get the status of an export. Ready exports include a download link that expires with the export
+// @Tags Profile
+// @Produce json
+// @Security ApiKeyAuth
+// @Param exportID path string true "Export ID"
+// @Success 200 {object} model.DataExport
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/me/export/{exportID} [get]
+func (h *Handler) getDataExport(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	exportID, err := getValueFromParams(c, "exportID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	export, err := h.DataExportService.Get(userID, exportID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, export)
+}
+
+// @Summary Download data export
+// @Description Download the zip archive of an export using the link from the export status
+// @Tags Profile
+// @Produce application/zip
+// @Param token query string true "Download token"
+// @Success 200 {file} file
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /exports/download [get]
+func (h *Handler) downloadDataExport(c echo.Context) error {
+	export, err := h.DataExportService.Download(c.QueryParam("token"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=\"todo-app-export-%s.zip\"", export.CreatedAt.Format("2006-01-02")))
+	c.Response().Header().Set("Cache-Control", "no-store")
+
+	return c.Blob(http.StatusOK, "application/zip", export.Archive)
+}
@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_createDataExport(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockDataExportServicer, userID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockDataExportServicer, userID uuid.UUID) {
+				s.EXPECT().Create(userID).Return(&model.DataExport{
+					ID:        uuid.Nil,
+					UserID:    userID,
+					Status:    model.DataExportPending,
+					CreatedAt: time.Unix(0, 0).UTC(),
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusAccepted,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","status":"pending","createdAt":"1970-01-01T00:00:00Z","completedAt":null,"expiresAt":null}` + "\n",
+		},
+		{
+			name: "Already In Progress",
+			mockBehavior: func(s *mock_service.MockDataExportServicer, userID uuid.UUID) {
+				s.EXPECT().Create(userID).Return(nil, errors.New("an export is already in progress"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"an export is already in progress"}`,
+		},
+		{
+			name: "Service Failure",
+			mockBehavior: func(s *mock_service.MockDataExportServicer, userID uuid.UUID) {
+				s.EXPECT().Create(userID).Return(nil, errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			exports := mock_service.NewMockDataExportServicer(c)
+			test.mockBehavior(exports, userID)
+
+			services := &service.Service{DataExportService: exports}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/me/export", nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.createDataExport(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_getDataExport(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockDataExportServicer, userID, exportID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		exportID            uuid.UUID
+		exportIDStr         string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:        "OK",
+			exportID:    uuid.Nil,
+			exportIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockDataExportServicer, userID, exportID uuid.UUID) {
+				completedAt := time.Unix(0, 0).UTC()
+				expiresAt := time.Unix(3600, 0).UTC()
+
+				s.EXPECT().Get(userID, exportID).Return(&model.DataExport{
+					ID:          exportID,
+					Status:      model.DataExportReady,
+					CreatedAt:   time.Unix(0, 0).UTC(),
+					CompletedAt: &completedAt,
+					ExpiresAt:   &expiresAt,
+					DownloadURL: "http://localhost:3000/exports/download?token=token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","status":"ready","createdAt":"1970-01-01T00:00:00Z","completedAt":"1970-01-01T00:00:00Z","expiresAt":"1970-01-01T01:00:00Z","downloadUrl":"http://localhost:3000/exports/download?token=token"}` + "\n",
+		},
+		{
+			name:                "Invalid ID",
+			exportID:            uuid.Nil,
+			exportIDStr:         "12312312",
+			mockBehavior:        func(s *mock_service.MockDataExportServicer, userID, exportID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:        "Not Found",
+			exportID:    uuid.Nil,
+			exportIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockDataExportServicer, userID, exportID uuid.UUID) {
+				s.EXPECT().Get(userID, exportID).Return(nil, errors.New("export not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"export not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			exports := mock_service.NewMockDataExportServicer(c)
+			test.mockBehavior(exports, userID, test.exportID)
+
+			services := &service.Service{DataExportService: exports}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/me/export/"+test.exportIDStr, nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("exportID")
+			ctx.SetParamValues(test.exportIDStr)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.getDataExport(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_downloadDataExport(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockDataExportServicer)
+
+	tests := []struct {
+		name                string
+		token               string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedDisposition string
+		expectedRequestBody string
+	}{
+		{
+			name:  "OK",
+			token: "token",
+			mockBehavior: func(s *mock_service.MockDataExportServicer) {
+				s.EXPECT().Download("token").Return(&model.DataExport{
+					Status:    model.DataExportReady,
+					Archive:   []byte("zip"),
+					CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedDisposition: `attachment; filename="todo-app-export-2024-01-02.zip"`,
+			expectedRequestBody: "zip",
+		},
+		{
+			name:  "Expired Link",
+			token: "expired",
+			mockBehavior: func(s *mock_service.MockDataExportServicer) {
+				s.EXPECT().Download("expired").Return(nil, errors.New("invalid or expired download link"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"invalid or expired download link"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			exports := mock_service.NewMockDataExportServicer(c)
+			test.mockBehavior(exports)
+
+			services := &service.Service{DataExportService: exports}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.GET("/exports/download", handler.downloadDataExport)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/exports/download?token="+test.token, nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedDisposition, w.Header().Get(echo.HeaderContentDisposition))
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
 	"github.com/rtsoy/todo-app/internal/service"
 )
 
@@ -17,31 +18,154 @@ func NewHandler(service *service.Service) *Handler {
 }
 
 func (h *Handler) InitRoutes(e *echo.Echo) {
+	e.GET("/.well-known/jwks.json", h.getJWKS)
+	e.GET("/exports/download", h.downloadDataExport)
+
 	auth := e.Group("/auth")
 	{
-		auth.POST("/sign-up", h.signUp)
-		auth.POST("/sign-in", h.signIn)
+		auth.POST("/sign-up", h.signUp, h.Audit(model.AuditSignUp))
+		auth.POST("/sign-in", h.signIn, h.Audit(model.AuditSignIn))
+		auth.POST("/sign-in/2fa", h.signInTwoFactor, h.Audit(model.AuditSignInTwoFactor))
+		auth.POST("/refresh", h.refresh)
+		auth.POST("/logout", h.logout)
+		auth.POST("/password/forgot", h.forgotPassword, h.Audit(model.AuditPasswordResetRequest))
+		auth.POST("/password/reset", h.resetPassword, h.Audit(model.AuditPasswordReset))
+		auth.GET("/verify", h.verifyEmail)
+		auth.POST("/verify/resend", h.resendVerification)
+		auth.GET("/oidc/:provider/login", h.oidcLogin)
+		auth.GET("/oidc/:provider/callback", h.oidcCallback, h.Audit(model.AuditSignInOIDC))
 	}
 
-	api := e.Group("/api", h.JWTAuthentication)
+	admin := e.Group("/admin", h.JWTAuthentication, h.RequireRole(model.RoleAdmin, model.RoleSupport))
 	{
+		admin.GET("/stats", h.getUsageStats)
+		admin.GET("/audit", h.getAuditEvents)
+		admin.POST("/lockouts/unlock", h.unlockSignIn, h.Audit(model.AuditAdminSignInUnlock))
+
+		users := admin.Group("/users")
+		{
+			users.GET("", h.getAllUsers)
+			users.GET("/:userID", h.getUserByID)
+			users.POST("/:userID/2fa/reset", h.resetUserTwoFactor, h.Audit(model.AuditAdminTwoFactorReset))
+			// Audit runs first, so support staff trying admin actions are recorded as well
+			users.POST("/:userID/disable", h.disableUser, h.Audit(model.AuditAdminUserDisable),
+				h.RequireRole(model.RoleAdmin))
+			users.POST("/:userID/enable", h.enableUser, h.Audit(model.AuditAdminUserEnable),
+				h.RequireRole(model.RoleAdmin))
+			users.PUT("/:userID/role", h.setUserRole, h.Audit(model.AuditAdminRoleChange),
+				h.RequireRole(model.RoleAdmin))
+			users.PUT("/:userID/plan", h.setUserPlan, h.Audit(model.AuditAdminPlanChange),
+				h.RequireRole(model.RoleAdmin))
+		}
+
+		invitations := admin.Group("/invitations", h.RequireRole(model.RoleAdmin))
+		{
+			invitations.POST("", h.createInvitation, h.Audit(model.AuditAdminInvitationCreate))
+			invitations.GET("", h.getAllInvitations)
+			invitations.DELETE("/:invitationID", h.deleteInvitation, h.Audit(model.AuditAdminInvitationRevoke))
+		}
+	}
+
+	api := e.Group("/api", h.JWTAuthentication, h.RequireVerifiedEmail)
+	{
+		me := api.Group("/me", h.RequireSession)
+		{
+			me.GET("", h.getMe)
+			me.PATCH("", h.updateMe, h.Audit(model.AuditAccountUpdate))
+			me.DELETE("", h.deleteMe, h.Audit(model.AuditAccountDelete))
+			me.POST("/password", h.changePassword, h.Audit(model.AuditPasswordChange))
+			me.POST("/export", h.createDataExport, h.Audit(model.AuditDataExport))
+			me.GET("/export/:exportID", h.getDataExport)
+			me.GET("/audit", h.getMyAuditEvents)
+			me.GET("/usage", h.getMyUsage)
+
+			sessions := me.Group("/sessions")
+			{
+				sessions.GET("", h.getAllSessions)
+				sessions.DELETE("", h.deleteAllSessions, h.Audit(model.AuditSessionRevokeAll))
+				sessions.DELETE("/:sessionID", h.deleteSession, h.Audit(model.AuditSessionRevoke))
+			}
+
+			twoFactor := me.Group("/2fa")
+			{
+				twoFactor.POST("", h.enrollTwoFactor)
+				twoFactor.POST("/confirm", h.confirmTwoFactor, h.Audit(model.AuditTwoFactorEnable))
+				twoFactor.POST("/disable", h.disableTwoFactor, h.Audit(model.AuditTwoFactorDisable))
+			}
+
+			tokens := me.Group("/tokens")
+			{
+				tokens.POST("", h.createAccessToken, h.Audit(model.AuditAccessTokenCreate))
+				tokens.GET("", h.getAllAccessTokens)
+				tokens.DELETE("/:tokenID", h.deleteAccessToken, h.Audit(model.AuditAccessTokenRevoke))
+			}
+		}
+
 		lists := api.Group("/lists")
 		{
-			lists.POST("", h.createList)
-			lists.GET("", h.getAllLists)
-			lists.GET("/:listID", h.getListByID)
-			lists.PATCH("/:listID", h.updateList)
-			lists.DELETE("/:listID", h.deleteList)
+			lists.POST("", h.createList, h.RequireScope(model.ScopeListsWrite))
+			lists.GET("", h.getAllLists, h.RequireScope(model.ScopeRead))
+			lists.GET("/:listID", h.getListByID, h.RequireScope(model.ScopeRead))
+			lists.PATCH("/:listID", h.updateList, h.RequireScope(model.ScopeListsWrite))
+			lists.DELETE("/:listID", h.deleteList, h.Audit(model.AuditListDelete), h.RequireScope(model.ScopeListsWrite))
+			lists.POST("/:listID/archive", h.archiveList, h.Audit(model.AuditListArchive),
+				h.RequireScope(model.ScopeListsWrite))
+			lists.POST("/:listID/unarchive", h.unarchiveList, h.Audit(model.AuditListUnarchive),
+				h.RequireScope(model.ScopeListsWrite))
+			lists.POST("/:listID/transfer", h.createListTransfer, h.Audit(model.AuditListTransferRequest),
+				h.RequireScope(model.ScopeListsWrite))
+			lists.DELETE("/:listID/transfer", h.cancelListTransfer, h.Audit(model.AuditListTransferCancel),
+				h.RequireScope(model.ScopeListsWrite))
+
+			members := lists.Group("/:listID/members")
+			{
+				members.POST("", h.addListMember, h.Audit(model.AuditListMemberAdd),
+					h.RequireScope(model.ScopeListsWrite))
+				members.GET("", h.getListMembers, h.RequireScope(model.ScopeRead))
+				members.PUT("/:memberID", h.updateListMember, h.Audit(model.AuditListMemberRole),
+					h.RequireScope(model.ScopeListsWrite))
+				members.DELETE("/:memberID", h.deleteListMember, h.Audit(model.AuditListMemberRemove),
+					h.RequireScope(model.ScopeListsWrite))
+			}
+
+			invites := lists.Group("/:listID/invites")
+			{
+				invites.POST("", h.createListInvite, h.Audit(model.AuditListInviteCreate),
+					h.RequireScope(model.ScopeListsWrite))
+				invites.GET("", h.getListInvites, h.RequireScope(model.ScopeRead))
+				invites.DELETE("/:inviteID", h.deleteListInvite, h.Audit(model.AuditListInviteRevoke),
+					h.RequireScope(model.ScopeListsWrite))
+			}
 
 			items := lists.Group("/:listID/items")
 			{
-				items.POST("", h.createItem)
-				items.GET("", h.getAllItems)
-				items.GET("/:itemID", h.getItemByID)
-				items.PATCH("/:itemID", h.updateItem)
-				items.DELETE("/:itemID", h.deleteItem)
+				items.POST("", h.createItem, h.RequireScope(model.ScopeItemsWrite))
+				items.GET("", h.getAllItems, h.RequireScope(model.ScopeRead))
+				items.GET("/:itemID", h.getItemByID, h.RequireScope(model.ScopeRead))
+				items.PATCH("/:itemID", h.updateItem, h.RequireScope(model.ScopeItemsWrite))
+				items.DELETE("/:itemID", h.deleteItem, h.Audit(model.AuditItemDelete),
+					h.RequireScope(model.ScopeItemsWrite))
 			}
 		}
+
+		trash := api.Group("/trash")
+		{
+			trash.GET("", h.getTrash, h.RequireScope(model.ScopeRead))
+			trash.POST("/:id/restore", h.restoreTrashEntry, h.Audit(model.AuditTrashRestore),
+				h.RequireScope(model.ScopeListsWrite), h.RequireScope(model.ScopeItemsWrite))
+		}
+
+		api.POST("/invites/:token/accept", h.acceptListInvite, h.Audit(model.AuditListInviteAccept),
+			h.RequireScope(model.ScopeListsWrite))
+
+		transfers := api.Group("/transfers")
+		{
+			transfers.GET("", h.getListTransfers, h.RequireScope(model.ScopeRead))
+			transfers.POST("/:transferID/accept", h.acceptListTransfer, h.Audit(model.AuditListTransferAccept),
+				h.RequireScope(model.ScopeListsWrite))
+			transfers.POST("/:transferID/decline", h.declineListTransfer, h.Audit(model.AuditListTransferDecline),
+				h.RequireScope(model.ScopeListsWrite))
+		}
 	}
 }
 
@@ -57,3 +181,16 @@ func getContextUserID(c echo.Context) uuid.UUID {
 
 	return userID
 }
+
+func getContextSessionID(c echo.Context) uuid.UUID {
+	sessionID, _ := c.Get(ctxSessionID).(uuid.UUID)
+
+	return sessionID
+}
+
+func getSessionMetadata(c echo.Context) model.SessionMetadata {
+	return model.SessionMetadata{
+		UserAgent: c.Request().UserAgent(),
+		IP:        c.RealIP(),
+	}
+}
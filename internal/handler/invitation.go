@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// @Summary Create an invitation
+// @Description Create an invitation code for invite-only registration. The code is only shown once. Admin role
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param input body model.CreateInvitationDTO true "Number of sign-ups it allows (default 1) and optional expiration"
+// @Success 201 {object} model.CreatedInvitation
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Router /admin/invitations [post]
+func (h *Handler) createInvitation(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	var input model.CreateInvitationDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	invitation, err := h.InvitationService.Create(userID, input)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	setAuditTarget(c, "invitation", invitation.ID.String())
+
+	return c.JSON(http.StatusCreated, invitation)
+}
+
+// @Summary Get all invitations
+// @Description Get all invitations that were not revoked, newest first. Admin role
+// @Tags Admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} resourceResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /admin/invitations [get]
+func (h *Handler) getAllInvitations(c echo.Context) error {
+	invitations, err := h.InvitationService.GetAll()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(invitations),
+		Results:    invitations,
+		Pagination: nil,
+	})
+}
+
+// @Summary Revoke an invitation
+// @Description Revoke an invitation so its code can no longer be used. Admin role
+// @Tags Admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param invitationID path string true "Invitation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /admin/invitations/{invitationID} [delete]
+func (h *Handler) deleteInvitation(c echo.Context) error {
+	invitationID, err := getValueFromParams(c, "invitationID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.InvitationService.Revoke(invitationID); err != nil {
+		if err.Error() == "invitation not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
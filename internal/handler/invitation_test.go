@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_createInvitation(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockInvitationServicer, userID uuid.UUID, input model.CreateInvitationDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.CreateInvitationDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"maxUses": 5}`,
+			inputData: model.CreateInvitationDTO{MaxUses: 5},
+			mockBehavior: func(s *mock_service.MockInvitationServicer, userID uuid.UUID, input model.CreateInvitationDTO) {
+				s.EXPECT().Create(userID, input).Return(model.CreatedInvitation{
+					Invitation: model.Invitation{
+						ID:        uuid.Nil,
+						MaxUses:   5,
+						CreatedAt: time.Unix(0, 0).UTC(),
+					},
+					Code: "invitation-code",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusCreated,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","createdBy":null,"maxUses":5,"uses":0,"createdAt":"1970-01-01T00:00:00Z","expiresAt":null,"code":"invitation-code"}` + "\n",
+		},
+		{
+			name:      "Invalid JSON",
+			inputBody: `{`,
+			mockBehavior: func(s *mock_service.MockInvitationServicer, userID uuid.UUID, input model.CreateInvitationDTO) {
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "Service Failure",
+			inputBody: `{"maxUses": -1}`,
+			inputData: model.CreateInvitationDTO{MaxUses: -1},
+			mockBehavior: func(s *mock_service.MockInvitationServicer, userID uuid.UUID, input model.CreateInvitationDTO) {
+				s.EXPECT().Create(userID, input).Return(model.CreatedInvitation{}, errors.New("max uses must be between 1 and 1000"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"max uses must be between 1 and 1000"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			invitation := mock_service.NewMockInvitationServicer(c)
+			test.mockBehavior(invitation, userID, test.inputData)
+
+			services := &service.Service{InvitationService: invitation}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/invitations", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.createInvitation(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_deleteInvitation(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockInvitationServicer, invitationID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		invitationID        uuid.UUID
+		invitationIDStr     string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:            "OK",
+			invitationID:    uuid.Nil,
+			invitationIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockInvitationServicer, invitationID uuid.UUID) {
+				s.EXPECT().Revoke(invitationID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			invitationID:        uuid.Nil,
+			invitationIDStr:     "12312312",
+			mockBehavior:        func(s *mock_service.MockInvitationServicer, invitationID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:            "Not Found",
+			invitationID:    uuid.Nil,
+			invitationIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockInvitationServicer, invitationID uuid.UUID) {
+				s.EXPECT().Revoke(invitationID).Return(errors.New("invitation not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"invitation not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			invitation := mock_service.NewMockInvitationServicer(c)
+			test.mockBehavior(invitation, test.invitationID)
+
+			services := &service.Service{InvitationService: invitation}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/admin/invitations/%s", test.invitationIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("invitationID")
+			ctx.SetParamValues(test.invitationIDStr)
+
+			err := handler.deleteInvitation(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
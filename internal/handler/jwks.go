@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Verifiers are expected to refetch the set when they meet an unknown kid, so it can be cached
+const jwksCacheControl = "public, max-age=300"
+
+// @Summary JSON Web Key Set
+// @Description Public keys for verifying tokens issued by the application, identified by kid
+// @Tags Keys
+// @Produce json
+// @Success 200 {object} swaggerJWKSResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /.well-known/jwks.json [get]
+func (h *Handler) getJWKS(c echo.Context) error {
+	set, err := h.KeyService.JWKS()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	c.Response().Header().Set("Cache-Control", jwksCacheControl)
+
+	return c.JSON(http.StatusOK, set)
+}
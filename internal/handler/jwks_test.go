@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/rtsoy/todo-app/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_getJWKS(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockKeyServicer)
+
+	tests := []struct {
+		name                 string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedCacheControl string
+		expectedRequestBody  string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockKeyServicer) {
+				s.EXPECT().JWKS().Return(jwk.Set{Keys: []jwk.Key{
+					{Kty: "OKP", Kid: "new", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"},
+					{Kty: "RSA", Kid: "old", Use: "sig", Alg: "RS256", N: "n", E: "AQAB"},
+				}}, nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedCacheControl: "public, max-age=300",
+			expectedRequestBody:  `{"keys":[{"kty":"OKP","kid":"new","use":"sig","alg":"EdDSA","crv":"Ed25519","x":"x"},{"kty":"RSA","kid":"old","use":"sig","alg":"RS256","n":"n","e":"AQAB"}]}`,
+		},
+		{
+			name: "Service Failure",
+			mockBehavior: func(s *mock_service.MockKeyServicer) {
+				s.EXPECT().JWKS().Return(jwk.Set{}, errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			keys := mock_service.NewMockKeyServicer(c)
+			test.mockBehavior(keys)
+
+			services := &service.Service{KeyService: keys}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.GET("/.well-known/jwks.json", handler.getJWKS)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedCacheControl, w.Header().Get("Cache-Control"))
+			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// @Summary Create a list invite
+// @Description Create an invite link that adds whoever accepts it to the list. The token is only shown once.
+// @Description Only the owner can invite people
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Param input body model.CreateListInviteDTO true "Role (default editor), number of uses (default 1) and optional expiration"
+// @Success 201 {object} model.CreatedListInvite
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/invites [post]
+func (h *Handler) createListInvite(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input model.CreateListInviteDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	invite, err := h.ListInviteService.Create(userID, listID, input)
+	if err != nil {
+		switch err.Error() {
+		case "todo list not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can manage invites":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	setAuditTarget(c, "list_invite", invite.ID.String())
+
+	return c.JSON(http.StatusCreated, invite)
+}
+
+// @Summary Get list invites
+// @Description Get the invites of a list that can still be accepted, newest first. Only the owner can see them
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Success 200 {object} resourceResponse
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/invites [get]
+func (h *Handler) getListInvites(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	invites, err := h.ListInviteService.GetAll(userID, listID)
+	if err != nil {
+		switch err.Error() {
+		case "todo list not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can manage invites":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(invites),
+		Results:    invites,
+		Pagination: nil,
+	})
+}
+
+// @Summary Revoke a list invite
+// @Description Revoke an invite so its link can no longer be used. Only the owner can revoke invites
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Param inviteID path string true "Invite ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/invites/{inviteID} [delete]
+func (h *Handler) deleteListInvite(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	inviteID, err := getValueFromParams(c, "inviteID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.ListInviteService.Revoke(userID, listID, inviteID); err != nil {
+		switch err.Error() {
+		case "todo list not found", "invite not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can manage invites":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Accept a list invite
+// @Description Join the list of an invite link with the role of the invite
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param token path string true "Invite token"
+// @Success 200 {object} model.TodoList
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
+// @Router /api/invites/{token}/accept [post]
+func (h *Handler) acceptListInvite(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	// The token must not end up in the audit log
+	setAuditTarget(c, "list_invite", "")
+
+	list, err := h.ListInviteService.Accept(userID, c.Param("token"))
+	if err != nil {
+		switch err.Error() {
+		case "invalid or expired invite":
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		case "you are already a member of this list", "exceeded the maximum allowed number of members in the list":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	setAuditTarget(c, "list", list.ID.String())
+
+	return c.JSON(http.StatusOK, list)
+}
@@ -0,0 +1,312 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_createListInvite(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockListInviteServicer, userID, listID uuid.UUID, input model.CreateListInviteDTO)
+
+	tests := []struct {
+		name                string
+		listIDStr           string
+		inputBody           string
+		inputData           model.CreateListInviteDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"role": "viewer", "maxUses": 5}`,
+			inputData: model.CreateListInviteDTO{Role: model.ListRoleViewer, MaxUses: 5},
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID, listID uuid.UUID, input model.CreateListInviteDTO) {
+				s.EXPECT().Create(userID, listID, input).Return(model.CreatedListInvite{
+					ListInvite: model.ListInvite{
+						ID:        uuid.Nil,
+						ListID:    listID,
+						Role:      model.ListRoleViewer,
+						MaxUses:   5,
+						CreatedAt: time.Unix(0, 0).UTC(),
+					},
+					Token: "invite-token",
+					Link:  "http://localhost:8080/invites/invite-token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusCreated,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","listId":"00000000-0000-0000-0000-000000000000","role":"viewer","createdBy":null,"maxUses":5,"uses":0,"createdAt":"1970-01-01T00:00:00Z","expiresAt":null,"token":"invite-token","link":"http://localhost:8080/invites/invite-token"}` + "\n",
+		},
+		{
+			name:      "Invalid ID",
+			listIDStr: "12312312",
+			inputBody: `{}`,
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID, listID uuid.UUID, input model.CreateListInviteDTO) {
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:      "Invalid JSON",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{`,
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID, listID uuid.UUID, input model.CreateListInviteDTO) {
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "Not Owner",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{}`,
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID, listID uuid.UUID, input model.CreateListInviteDTO) {
+				s.EXPECT().Create(userID, listID, input).Return(model.CreatedListInvite{}, errors.New("only the owner can manage invites"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"only the owner can manage invites"}`,
+		},
+		{
+			name:      "Service Failure",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"maxUses": -1}`,
+			inputData: model.CreateListInviteDTO{MaxUses: -1},
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID, listID uuid.UUID, input model.CreateListInviteDTO) {
+				s.EXPECT().Create(userID, listID, input).Return(model.CreatedListInvite{}, errors.New("max uses must be between 1 and 1000"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"max uses must be between 1 and 1000"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			listInvite := mock_service.NewMockListInviteServicer(c)
+			test.mockBehavior(listInvite, userID, uuid.Nil, test.inputData)
+
+			services := &service.Service{ListInviteService: listInvite}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/lists/%s/invites", test.listIDStr),
+				bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("listID")
+			ctx.SetParamValues(test.listIDStr)
+
+			err := handler.createListInvite(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_deleteListInvite(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockListInviteServicer, userID, listID, inviteID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		inviteIDStr         string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:        "OK",
+			inviteIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID, listID, inviteID uuid.UUID) {
+				s.EXPECT().Revoke(userID, listID, inviteID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			inviteIDStr:         "12312312",
+			mockBehavior:        func(s *mock_service.MockListInviteServicer, userID, listID, inviteID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:        "Not Found",
+			inviteIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID, listID, inviteID uuid.UUID) {
+				s.EXPECT().Revoke(userID, listID, inviteID).Return(errors.New("invite not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"invite not found"}`,
+		},
+		{
+			name:        "Not Owner",
+			inviteIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID, listID, inviteID uuid.UUID) {
+				s.EXPECT().Revoke(userID, listID, inviteID).Return(errors.New("only the owner can manage invites"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"only the owner can manage invites"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			listInvite := mock_service.NewMockListInviteServicer(c)
+			inviteID, _ := uuid.Parse(test.inviteIDStr)
+			test.mockBehavior(listInvite, userID, uuid.Nil, inviteID)
+
+			services := &service.Service{ListInviteService: listInvite}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete,
+				fmt.Sprintf("/api/lists/%s/invites/%s", uuid.Nil, test.inviteIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("listID", "inviteID")
+			ctx.SetParamValues(uuid.Nil.String(), test.inviteIDStr)
+
+			err := handler.deleteListInvite(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_acceptListInvite(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockListInviteServicer, userID uuid.UUID, token string)
+
+	tests := []struct {
+		name                string
+		token               string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:  "OK",
+			token: "invite-token",
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID uuid.UUID, token string) {
+				s.EXPECT().Accept(userID, token).Return(model.TodoList{
+					ID:          uuid.Nil,
+					Title:       "test",
+					Description: "example",
+					CreatedAt:   time.Unix(0, 0).UTC(),
+					Role:        model.ListRoleEditor,
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","title":"test","description":"example","createdAt":"1970-01-01T00:00:00Z","archivedAt":null,"role":"editor"}` + "\n",
+		},
+		{
+			name:  "Invalid Invite",
+			token: "invite-token",
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID uuid.UUID, token string) {
+				s.EXPECT().Accept(userID, token).Return(model.TodoList{}, errors.New("invalid or expired invite"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid or expired invite"}`,
+		},
+		{
+			name:  "Already Member",
+			token: "invite-token",
+			mockBehavior: func(s *mock_service.MockListInviteServicer, userID uuid.UUID, token string) {
+				s.EXPECT().Accept(userID, token).Return(model.TodoList{}, errors.New("you are already a member of this list"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"you are already a member of this list"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			listInvite := mock_service.NewMockListInviteServicer(c)
+			test.mockBehavior(listInvite, userID, test.token)
+
+			services := &service.Service{ListInviteService: listInvite}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/invites/%s/accept", test.token), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("token")
+			ctx.SetParamValues(test.token)
+
+			err := handler.acceptListInvite(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
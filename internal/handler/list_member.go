@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// @Summary Share a list
+// @Description Share a list with another user by their username or email, as an editor (default), commenter or
+// @Description viewer. Only the owner can share a list. Commenters can read the list like viewers, the role
+// @Description is reserved for commenting on items
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Param input body model.AddListMemberDTO true "Username or email of the user and their role"
+// @Success 201 {object} model.ListMember
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/members [post]
+func (h *Handler) addListMember(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input model.AddListMemberDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	member, err := h.TodoListService.AddMember(userID, listID, input)
+	if err != nil {
+		switch err.Error() {
+		case "todo list not found", "user not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can manage members":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "user is already a member", "exceeded the maximum allowed number of members in the list":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		case "role must be editor, commenter or viewer":
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.JSON(http.StatusCreated, member)
+}
+
+// @Summary Get list members
+// @Description Get the owner and everyone the list is shared with
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Success 200 {object} resourceResponse
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/members [get]
+func (h *Handler) getListMembers(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	members, err := h.TodoListService.GetMembers(userID, listID)
+	if err != nil {
+		if err.Error() == "todo list not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(members),
+		Results:    members,
+		Pagination: nil,
+	})
+}
+
+// @Summary Change the role of a list member
+// @Description Change the role of a member to editor, commenter or viewer. Only the owner can change roles
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Param memberID path string true "User ID of the member"
+// @Param input body model.UpdateListMemberDTO true "New role"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/members/{memberID} [put]
+func (h *Handler) updateListMember(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	memberID, err := getValueFromParams(c, "memberID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input model.UpdateListMemberDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	if err := h.TodoListService.UpdateMember(userID, listID, memberID, input); err != nil {
+		switch err.Error() {
+		case "todo list not found", "member not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can manage members":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "role must be editor, commenter or viewer":
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Remove a list member
+// @Description Stop sharing a list with a member. The owner can remove anyone, members can remove themselves
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Param memberID path string true "User ID of the member"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/members/{memberID} [delete]
+func (h *Handler) deleteListMember(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	memberID, err := getValueFromParams(c, "memberID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.TodoListService.RemoveMember(userID, listID, memberID); err != nil {
+		switch err.Error() {
+		case "todo list not found", "member not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can manage members":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "the owner cannot leave the list":
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
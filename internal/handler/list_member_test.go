@@ -0,0 +1,443 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_addListMember(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.AddListMemberDTO)
+
+	tests := []struct {
+		name                string
+		listIDStr           string
+		inputBody           string
+		inputData           model.AddListMemberDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"login": "alice"}`,
+			inputData: model.AddListMemberDTO{Login: "alice"},
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.AddListMemberDTO) {
+				s.EXPECT().AddMember(userID, listID, input).Return(model.ListMember{
+					UserID:   uuid.Nil,
+					Username: "alice",
+					Email:    "alice@example.com",
+					Role:     model.ListRoleEditor,
+					AddedAt:  time.Unix(0, 0).UTC(),
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusCreated,
+			expectedRequestBody: `{"userId":"00000000-0000-0000-0000-000000000000","username":"alice","email":"alice@example.com","role":"editor","addedAt":"1970-01-01T00:00:00Z"}` + "\n",
+		},
+		{
+			name:                "Invalid ID",
+			listIDStr:           "12312312",
+			inputBody:           `{"login": "alice"}`,
+			mockBehavior:        func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.AddListMemberDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:                "Invalid JSON",
+			listIDStr:           uuid.Nil.String(),
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.AddListMemberDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "User Not Found",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"login": "nobody"}`,
+			inputData: model.AddListMemberDTO{Login: "nobody"},
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.AddListMemberDTO) {
+				s.EXPECT().AddMember(userID, listID, input).Return(model.ListMember{}, errors.New("user not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"user not found"}`,
+		},
+		{
+			name:      "Not Owner",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"login": "alice"}`,
+			inputData: model.AddListMemberDTO{Login: "alice"},
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.AddListMemberDTO) {
+				s.EXPECT().AddMember(userID, listID, input).Return(model.ListMember{}, errors.New("only the owner can manage members"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"only the owner can manage members"}`,
+		},
+		{
+			name:      "Already Member",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"login": "alice"}`,
+			inputData: model.AddListMemberDTO{Login: "alice"},
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.AddListMemberDTO) {
+				s.EXPECT().AddMember(userID, listID, input).Return(model.ListMember{}, errors.New("user is already a member"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"user is already a member"}`,
+		},
+		{
+			name:      "Quota Exceeded",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"login": "alice"}`,
+			inputData: model.AddListMemberDTO{Login: "alice"},
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.AddListMemberDTO) {
+				s.EXPECT().AddMember(userID, listID, input).Return(model.ListMember{},
+					errors.New("exceeded the maximum allowed number of members in the list"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"exceeded the maximum allowed number of members in the list"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			todoList := mock_service.NewMockTodoListServicer(c)
+			test.mockBehavior(todoList, userID, uuid.Nil, test.inputData)
+
+			services := &service.Service{TodoListService: todoList}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/lists/%s/members", test.listIDStr),
+				bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("listID")
+			ctx.SetParamValues(test.listIDStr)
+
+			err := handler.addListMember(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_getListMembers(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		listIDStr           string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().GetMembers(userID, listID).Return([]model.ListMember{
+					{
+						UserID:   uuid.Nil,
+						Username: "bob",
+						Email:    "bob@example.com",
+						Role:     model.ListRoleOwner,
+						AddedAt:  time.Unix(0, 0).UTC(),
+					},
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"count":1,"results":[{"userId":"00000000-0000-0000-0000-000000000000","username":"bob","email":"bob@example.com","role":"owner","addedAt":"1970-01-01T00:00:00Z"}],"pagination":null}` + "\n",
+		},
+		{
+			name:                "Invalid ID",
+			listIDStr:           "12312312",
+			mockBehavior:        func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:      "Not Found",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().GetMembers(userID, listID).Return(nil, errors.New("todo list not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"todo list not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			todoList := mock_service.NewMockTodoListServicer(c)
+			test.mockBehavior(todoList, userID, uuid.Nil)
+
+			services := &service.Service{TodoListService: todoList}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/lists/%s/members", test.listIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("listID")
+			ctx.SetParamValues(test.listIDStr)
+
+			err := handler.getListMembers(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_updateListMember(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID, input model.UpdateListMemberDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.UpdateListMemberDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"role": "viewer"}`,
+			inputData: model.UpdateListMemberDTO{Role: model.ListRoleViewer},
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID, input model.UpdateListMemberDTO) {
+				s.EXPECT().UpdateMember(userID, listID, memberID, input).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:      "Invalid JSON",
+			inputBody: `{`,
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID, input model.UpdateListMemberDTO) {
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "Invalid Role",
+			inputBody: `{"role": "owner"}`,
+			inputData: model.UpdateListMemberDTO{Role: model.ListRoleOwner},
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID, input model.UpdateListMemberDTO) {
+				s.EXPECT().UpdateMember(userID, listID, memberID, input).Return(errors.New("role must be editor, commenter or viewer"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"role must be editor, commenter or viewer"}`,
+		},
+		{
+			name:      "Not Owner",
+			inputBody: `{"role": "viewer"}`,
+			inputData: model.UpdateListMemberDTO{Role: model.ListRoleViewer},
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID, input model.UpdateListMemberDTO) {
+				s.EXPECT().UpdateMember(userID, listID, memberID, input).Return(errors.New("only the owner can manage members"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"only the owner can manage members"}`,
+		},
+		{
+			name:      "Member Not Found",
+			inputBody: `{"role": "viewer"}`,
+			inputData: model.UpdateListMemberDTO{Role: model.ListRoleViewer},
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID, input model.UpdateListMemberDTO) {
+				s.EXPECT().UpdateMember(userID, listID, memberID, input).Return(errors.New("member not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"member not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			todoList := mock_service.NewMockTodoListServicer(c)
+			test.mockBehavior(todoList, userID, uuid.Nil, uuid.Nil, test.inputData)
+
+			services := &service.Service{TodoListService: todoList}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/lists/%s/members/%s", uuid.Nil, uuid.Nil),
+				bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("listID", "memberID")
+			ctx.SetParamValues(uuid.Nil.String(), uuid.Nil.String())
+
+			err := handler.updateListMember(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_deleteListMember(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		memberIDStr         string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:        "OK",
+			memberIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID) {
+				s.EXPECT().RemoveMember(userID, listID, memberID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			memberIDStr:         "12312312",
+			mockBehavior:        func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:        "Not Owner",
+			memberIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID) {
+				s.EXPECT().RemoveMember(userID, listID, memberID).Return(errors.New("only the owner can manage members"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"only the owner can manage members"}`,
+		},
+		{
+			name:        "Owner Leaving",
+			memberIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID) {
+				s.EXPECT().RemoveMember(userID, listID, memberID).Return(errors.New("the owner cannot leave the list"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"the owner cannot leave the list"}`,
+		},
+		{
+			name:        "Member Not Found",
+			memberIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID, memberID uuid.UUID) {
+				s.EXPECT().RemoveMember(userID, listID, memberID).Return(errors.New("member not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"member not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			todoList := mock_service.NewMockTodoListServicer(c)
+			memberID, _ := uuid.Parse(test.memberIDStr)
+			test.mockBehavior(todoList, userID, uuid.Nil, memberID)
+
+			services := &service.Service{TodoListService: todoList}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete,
+				fmt.Sprintf("/api/lists/%s/members/%s", uuid.Nil, test.memberIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("listID", "memberID")
+			ctx.SetParamValues(uuid.Nil.String(), test.memberIDStr)
+
+			err := handler.deleteListMember(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
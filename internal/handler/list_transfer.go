@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// @Summary Transfer a list
+// @Description Offer the ownership of a list to another user by their username or email. The list changes hands
+// @Description once they accept, a new offer replaces the pending one. Only the owner can transfer a list
+// @Tags Lists
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Param input body model.CreateListTransferDTO true "Username or email of the new owner"
+// @Success 201 {object} model.ListTransfer
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/transfer [post]
+func (h *Handler) createListTransfer(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	var input model.CreateListTransferDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	transfer, err := h.ListTransferService.Create(userID, listID, input)
+	if err != nil {
+		switch err.Error() {
+		case "todo list not found", "user not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can transfer the list":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "you already own this list":
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.JSON(http.StatusCreated, transfer)
+}
+
+// @Summary Cancel a list transfer
+// @Description Cancel the pending ownership transfer of a list
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/transfer [delete]
+func (h *Handler) cancelListTransfer(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.ListTransferService.Cancel(userID, listID); err != nil {
+		switch err.Error() {
+		case "todo list not found", "transfer not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can transfer the list":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Get incoming list transfers
+// @Description Get the lists other users offered to transfer to the current user, newest first
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} resourceResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/transfers [get]
+func (h *Handler) getListTransfers(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	transfers, err := h.ListTransferService.GetIncoming(userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(transfers),
+		Results:    transfers,
+		Pagination: nil,
+	})
+}
+
+// @Summary Accept a list transfer
+// @Description Become the owner of the list. The previous owner stays on the list as an editor and counts
+// @Description towards the member quota, archived lists do not count towards the list quota
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param transferID path string true "Transfer ID"
+// @Success 200 {object} model.TodoList
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
+// @Router /api/transfers/{transferID}/accept [post]
+func (h *Handler) acceptListTransfer(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	transferID, err := getValueFromParams(c, "transferID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	list, err := h.ListTransferService.Accept(userID, transferID)
+	if err != nil {
+		switch err.Error() {
+		case "transfer not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "exceeded the maximum allowed limit of existing lists",
+			"exceeded the maximum allowed number of members in the list":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	setAuditTarget(c, "list", list.ID.String())
+
+	return c.JSON(http.StatusOK, list)
+}
+
+// @Summary Decline a list transfer
+// @Description Decline the ownership of a list offered to the current user
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param transferID path string true "Transfer ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/transfers/{transferID}/decline [post]
+func (h *Handler) declineListTransfer(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	transferID, err := getValueFromParams(c, "transferID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.ListTransferService.Decline(userID, transferID); err != nil {
+		if err.Error() == "transfer not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,310 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_createListTransfer(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockListTransferServicer, userID, listID uuid.UUID, input model.CreateListTransferDTO)
+
+	tests := []struct {
+		name                string
+		listIDStr           string
+		inputBody           string
+		inputData           model.CreateListTransferDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"login": "alice"}`,
+			inputData: model.CreateListTransferDTO{Login: "alice"},
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, listID uuid.UUID, input model.CreateListTransferDTO) {
+				s.EXPECT().Create(userID, listID, input).Return(model.ListTransfer{
+					ID:           uuid.Nil,
+					ListID:       listID,
+					ListTitle:    "test",
+					FromUserID:   uuid.Nil,
+					FromUsername: "bob",
+					ToUserID:     uuid.Nil,
+					CreatedAt:    time.Unix(0, 0).UTC(),
+					ExpiresAt:    time.Unix(0, 0).UTC(),
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusCreated,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","listId":"00000000-0000-0000-0000-000000000000","listTitle":"test","fromUserId":"00000000-0000-0000-0000-000000000000","fromUsername":"bob","toUserId":"00000000-0000-0000-0000-000000000000","createdAt":"1970-01-01T00:00:00Z","expiresAt":"1970-01-01T00:00:00Z"}` + "\n",
+		},
+		{
+			name:      "Invalid ID",
+			listIDStr: "12312312",
+			inputBody: `{"login": "alice"}`,
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, listID uuid.UUID, input model.CreateListTransferDTO) {
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:      "Invalid JSON",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{`,
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, listID uuid.UUID, input model.CreateListTransferDTO) {
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "Not Owner",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"login": "alice"}`,
+			inputData: model.CreateListTransferDTO{Login: "alice"},
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, listID uuid.UUID, input model.CreateListTransferDTO) {
+				s.EXPECT().Create(userID, listID, input).Return(model.ListTransfer{}, errors.New("only the owner can transfer the list"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"only the owner can transfer the list"}`,
+		},
+		{
+			name:      "User Not Found",
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"login": "nobody"}`,
+			inputData: model.CreateListTransferDTO{Login: "nobody"},
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, listID uuid.UUID, input model.CreateListTransferDTO) {
+				s.EXPECT().Create(userID, listID, input).Return(model.ListTransfer{}, errors.New("user not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"user not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			listTransfer := mock_service.NewMockListTransferServicer(c)
+			test.mockBehavior(listTransfer, userID, uuid.Nil, test.inputData)
+
+			services := &service.Service{ListTransferService: listTransfer}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/lists/%s/transfer", test.listIDStr),
+				bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("listID")
+			ctx.SetParamValues(test.listIDStr)
+
+			err := handler.createListTransfer(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_acceptListTransfer(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockListTransferServicer, userID, transferID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		transferIDStr       string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:          "OK",
+			transferIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, transferID uuid.UUID) {
+				s.EXPECT().Accept(userID, transferID).Return(model.TodoList{
+					ID:          uuid.Nil,
+					Title:       "test",
+					Description: "example",
+					CreatedAt:   time.Unix(0, 0).UTC(),
+					Role:        model.ListRoleOwner,
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","title":"test","description":"example","createdAt":"1970-01-01T00:00:00Z","archivedAt":null,"role":"owner"}` + "\n",
+		},
+		{
+			name:                "Invalid ID",
+			transferIDStr:       "12312312",
+			mockBehavior:        func(s *mock_service.MockListTransferServicer, userID, transferID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:          "Not Found",
+			transferIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, transferID uuid.UUID) {
+				s.EXPECT().Accept(userID, transferID).Return(model.TodoList{}, errors.New("transfer not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"transfer not found"}`,
+		},
+		{
+			name:          "List Limit",
+			transferIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, transferID uuid.UUID) {
+				s.EXPECT().Accept(userID, transferID).Return(model.TodoList{},
+					errors.New("exceeded the maximum allowed limit of existing lists"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"exceeded the maximum allowed limit of existing lists"}`,
+		},
+		{
+			name:          "Member Limit",
+			transferIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, transferID uuid.UUID) {
+				s.EXPECT().Accept(userID, transferID).Return(model.TodoList{},
+					errors.New("exceeded the maximum allowed number of members in the list"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"exceeded the maximum allowed number of members in the list"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			listTransfer := mock_service.NewMockListTransferServicer(c)
+			transferID, _ := uuid.Parse(test.transferIDStr)
+			test.mockBehavior(listTransfer, userID, transferID)
+
+			services := &service.Service{ListTransferService: listTransfer}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/transfers/%s/accept", test.transferIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("transferID")
+			ctx.SetParamValues(test.transferIDStr)
+
+			err := handler.acceptListTransfer(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_declineListTransfer(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockListTransferServicer, userID, transferID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, transferID uuid.UUID) {
+				s.EXPECT().Decline(userID, transferID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name: "Not Found",
+			mockBehavior: func(s *mock_service.MockListTransferServicer, userID, transferID uuid.UUID) {
+				s.EXPECT().Decline(userID, transferID).Return(errors.New("transfer not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"transfer not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			listTransfer := mock_service.NewMockListTransferServicer(c)
+			test.mockBehavior(listTransfer, userID, uuid.Nil)
+
+			services := &service.Service{ListTransferService: listTransfer}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/transfers/%s/decline", uuid.Nil), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.SetParamNames("transferID")
+			ctx.SetParamValues(uuid.Nil.String())
+
+			err := handler.declineListTransfer(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
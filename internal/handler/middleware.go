@@ -1,48 +1,172 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
-	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
 )
 
-const ctxUserID = "userID"
+const (
+	ctxUserID      = "userID"
+	ctxSessionID   = "sessionID"
+	ctxTokenID     = "tokenID"
+	ctxRole        = "role"
+	ctxAccessToken = "accessToken"
+	ctxAuditUserID = "auditUserID"
+	ctxAuditTarget = "auditTarget"
+)
 
 func (h *Handler) JWTAuthentication(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		header := c.Request().Header.Get("Authorization")
 
 		if header == "" {
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
 			return echo.NewHTTPError(http.StatusUnauthorized, "empty auth header")
 		}
 
 		headerParts := strings.Split(header, " ")
 		if len(headerParts) != 2 || headerParts[0] != "Bearer" {
-			return echo.NewHTTPError(http.StatusUnauthorized, "invalid auth token")
+			return invalidToken(c, "invalid auth token")
 		}
 
 		if headerParts[1] == "" {
-			return echo.NewHTTPError(http.StatusUnauthorized, "no token provided")
+			return invalidToken(c, "no token provided")
+		}
+
+		if strings.HasPrefix(headerParts[1], service.AccessTokenPrefix) {
+			token, err := h.AccessTokenService.Authenticate(headerParts[1])
+			if err != nil {
+				return invalidToken(c, err.Error())
+			}
+
+			c.Set(ctxUserID, token.UserID.String())
+			c.Set(ctxAccessToken, token)
+
+			return next(c)
 		}
 
 		claims, err := h.UserService.ParseToken(headerParts[1])
 		if err != nil {
-			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+			return invalidToken(c, err.Error())
+		}
+
+		userID, err := uuid.Parse(claims.Subject)
+		if err != nil {
+			return invalidToken(c, "invalid auth token")
 		}
 
-		expires := claims["expires"]
-		expiresTime, _ := time.Parse(time.RFC3339, expires.(string))
+		sessionID, err := uuid.Parse(claims.SessionID)
+		if err != nil {
+			return invalidToken(c, "invalid auth token")
+		}
 
-		if time.Now().UTC().After(expiresTime) {
-			return echo.NewHTTPError(http.StatusUnauthorized, "token is expired")
+		if err := h.SessionService.Validate(sessionID); err != nil {
+			return invalidToken(c, err.Error())
 		}
 
-		userID := claims["userID"]
+		c.Set(ctxUserID, userID.String())
+		c.Set(ctxSessionID, sessionID)
+		c.Set(ctxTokenID, claims.ID)
+		c.Set(ctxRole, claims.Role)
+
+		return next(c)
+	}
+}
+
+// invalidToken rejects the request with the reason in the body and, as RFC 6750 asks,
+// in the WWW-Authenticate header.
+func invalidToken(c echo.Context, reason string) error {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate,
+		fmt.Sprintf("Bearer error=\"invalid_token\", error_description=%q", reason))
+
+	return echo.NewHTTPError(http.StatusUnauthorized, reason)
+}
+
+// RequireScope limits requests made with a personal access token to the scopes the
+// token was granted. Requests authenticated with a session are not limited.
+func (h *Handler) RequireScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token, ok := c.Get(ctxAccessToken).(*model.PersonalAccessToken)
+			if ok && !token.HasScope(scope) {
+				return echo.NewHTTPError(http.StatusForbidden, "access token lacks the "+scope+" scope")
+			}
 
-		c.Set(ctxUserID, userID)
+			return next(c)
+		}
+	}
+}
+
+// RequireSession rejects requests made with a personal access token, e.g. for account management.
+func (h *Handler) RequireSession(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if _, ok := c.Get(ctxAccessToken).(*model.PersonalAccessToken); ok {
+			return echo.NewHTTPError(http.StatusForbidden, "this endpoint requires a signed-in session")
+		}
 
 		return next(c)
 	}
 }
+
+// RequireRole allows the request only if the access token carries one of the roles, it must
+// run after JWTAuthentication. Personal access tokens carry no role.
+func (h *Handler) RequireRole(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			role, _ := c.Get(ctxRole).(string)
+
+			for _, r := range roles {
+				if role == r {
+					return next(c)
+				}
+			}
+
+			return echo.NewHTTPError(http.StatusForbidden, "insufficient role")
+		}
+	}
+}
+
+// Audit records the request as an audit event once the handler returned. The target is the
+// last path parameter unless the handler names it with setAuditTarget.
+func (h *Handler) Audit(action string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := next(c)
+
+			// A failed write must not fail the request it describes
+			if auditErr := h.AuditService.Record(newAuditEvent(c, action, err)); auditErr != nil {
+				c.Logger().Error(auditErr)
+			}
+
+			return err
+		}
+	}
+}
+
+// RequireVerifiedEmail enforces the email verification policy, it must run after JWTAuthentication.
+func (h *Handler) RequireVerifiedEmail(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		userID := getContextUserID(c)
+
+		if err := h.EmailVerificationService.CheckAccess(userID, isWriteRequest(c)); err != nil {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+
+		return next(c)
+	}
+}
+
+func isWriteRequest(c echo.Context) bool {
+	switch c.Request().Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
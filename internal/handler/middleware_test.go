@@ -5,10 +5,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
-	"time"
 
-	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
 	"github.com/rtsoy/todo-app/internal/service"
 	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
 	"github.com/stretchr/testify/assert"
@@ -16,91 +16,140 @@ import (
 )
 
 func TestHandler_JWTAuthentication(t *testing.T) {
-	type mockBehavior func(s *mock_service.MockUserServicer, token string)
+	type mockBehavior func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string)
+
+	userID := uuid.New()
 
 	tests := []struct {
-		name                string
-		headerName          string
-		headerValue         string
-		token               string
-		mockBehavior        mockBehavior
-		expectedStatusCode  int
-		expectedRequestBody string
+		name                 string
+		headerName           string
+		headerValue          string
+		token                string
+		mockBehavior         mockBehavior
+		expectedStatusCode   int
+		expectedAuthenticate string
+		expectedRequestBody  string
 	}{
 		{
 			name:        "OK",
 			headerName:  "Authorization",
 			headerValue: "Bearer token",
 			token:       "token",
-			mockBehavior: func(s *mock_service.MockUserServicer, token string) {
-				s.EXPECT().ParseToken(token).Return(jwt.MapClaims{
-					"userID":  1,
-					"expires": time.Now().Add(time.Minute).Format(time.RFC3339),
+			mockBehavior: func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {
+				s.EXPECT().ParseToken(token).Return(&model.AccessTokenClaims{
+					RegisteredClaims: model.RegisteredClaims{Subject: userID.String(), ID: "jti"},
+					SessionID:        uuid.Nil.String(),
 				}, nil)
+				ss.EXPECT().Validate(uuid.Nil).Return(nil)
 			},
 			expectedStatusCode:  http.StatusOK,
-			expectedRequestBody: `{"userID":1}`,
+			expectedRequestBody: `{"userID":"` + userID.String() + `"}`,
 		},
 		{
-			name:                "Empty Auth Header",
-			headerName:          "Authorization",
-			headerValue:         "",
-			token:               "",
-			mockBehavior:        func(s *mock_service.MockUserServicer, token string) {},
-			expectedStatusCode:  http.StatusUnauthorized,
-			expectedRequestBody: `{"message":"empty auth header"}`,
+			name:                 "Empty Auth Header",
+			headerName:           "Authorization",
+			headerValue:          "",
+			token:                "",
+			mockBehavior:         func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedAuthenticate: "Bearer",
+			expectedRequestBody:  `{"message":"empty auth header"}`,
 		},
 		{
-			name:                "Invalid Auth Header",
-			headerName:          "Authorization",
-			headerValue:         "Baerer",
-			token:               "",
-			mockBehavior:        func(s *mock_service.MockUserServicer, token string) {},
-			expectedStatusCode:  http.StatusUnauthorized,
-			expectedRequestBody: `{"message":"invalid auth token"}`,
+			name:                 "Invalid Auth Header",
+			headerName:           "Authorization",
+			headerValue:          "Baerer",
+			token:                "",
+			mockBehavior:         func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedAuthenticate: `Bearer error="invalid_token", error_description="invalid auth token"`,
+			expectedRequestBody:  `{"message":"invalid auth token"}`,
 		},
 		{
-			name:                "Invalid Auth Header",
-			headerName:          "Authorization",
-			headerValue:         "Bearer qwe 1",
-			token:               "",
-			mockBehavior:        func(s *mock_service.MockUserServicer, token string) {},
-			expectedStatusCode:  http.StatusUnauthorized,
-			expectedRequestBody: `{"message":"invalid auth token"}`,
+			name:                 "Invalid Auth Header",
+			headerName:           "Authorization",
+			headerValue:          "Bearer qwe 1",
+			token:                "",
+			mockBehavior:         func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedAuthenticate: `Bearer error="invalid_token", error_description="invalid auth token"`,
+			expectedRequestBody:  `{"message":"invalid auth token"}`,
 		},
 		{
-			name:                "Invalid Auth Header",
-			headerName:          "Authorization",
-			headerValue:         "Bearer ",
-			token:               "",
-			mockBehavior:        func(s *mock_service.MockUserServicer, token string) {},
-			expectedStatusCode:  http.StatusUnauthorized,
-			expectedRequestBody: `{"message":"no token provided"}`,
+			name:                 "Invalid Auth Header",
+			headerName:           "Authorization",
+			headerValue:          "Bearer ",
+			token:                "",
+			mockBehavior:         func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedAuthenticate: `Bearer error="invalid_token", error_description="no token provided"`,
+			expectedRequestBody:  `{"message":"no token provided"}`,
 		},
 		{
 			name:        "Parse Error",
 			headerName:  "Authorization",
 			headerValue: "Bearer token",
 			token:       "token",
-			mockBehavior: func(s *mock_service.MockUserServicer, token string) {
-				s.EXPECT().ParseToken(token).Return(nil, errors.New("parse error"))
+			mockBehavior: func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {
+				s.EXPECT().ParseToken(token).Return(nil, errors.New("token signature is invalid"))
 			},
-			expectedStatusCode:  http.StatusUnauthorized,
-			expectedRequestBody: `{"message":"parse error"}`,
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedAuthenticate: `Bearer error="invalid_token", error_description="token signature is invalid"`,
+			expectedRequestBody:  `{"message":"token signature is invalid"}`,
 		},
 		{
 			name:        "Expired Token",
 			headerName:  "Authorization",
 			headerValue: "Bearer token",
 			token:       "token",
-			mockBehavior: func(s *mock_service.MockUserServicer, token string) {
-				s.EXPECT().ParseToken(token).Return(jwt.MapClaims{
-					"userID":  1,
-					"expires": time.Now().AddDate(0, 0, -1).Format(time.RFC3339),
+			mockBehavior: func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {
+				s.EXPECT().ParseToken(token).Return(nil, errors.New("token is expired"))
+			},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedAuthenticate: `Bearer error="invalid_token", error_description="token is expired"`,
+			expectedRequestBody:  `{"message":"token is expired"}`,
+		},
+		{
+			name:        "Wrong Audience",
+			headerName:  "Authorization",
+			headerValue: "Bearer token",
+			token:       "token",
+			mockBehavior: func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {
+				s.EXPECT().ParseToken(token).Return(nil, errors.New("token has an unexpected audience"))
+			},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedAuthenticate: `Bearer error="invalid_token", error_description="token has an unexpected audience"`,
+			expectedRequestBody:  `{"message":"token has an unexpected audience"}`,
+		},
+		{
+			name:        "Missing Session",
+			headerName:  "Authorization",
+			headerValue: "Bearer token",
+			token:       "token",
+			mockBehavior: func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {
+				s.EXPECT().ParseToken(token).Return(&model.AccessTokenClaims{
+					RegisteredClaims: model.RegisteredClaims{Subject: userID.String(), ID: "jti"},
 				}, nil)
 			},
-			expectedStatusCode:  http.StatusUnauthorized,
-			expectedRequestBody: `{"message":"token is expired"}`,
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedAuthenticate: `Bearer error="invalid_token", error_description="invalid auth token"`,
+			expectedRequestBody:  `{"message":"invalid auth token"}`,
+		},
+		{
+			name:        "Revoked Session",
+			headerName:  "Authorization",
+			headerValue: "Bearer token",
+			token:       "token",
+			mockBehavior: func(s *mock_service.MockUserServicer, ss *mock_service.MockSessionServicer, token string) {
+				s.EXPECT().ParseToken(token).Return(&model.AccessTokenClaims{
+					RegisteredClaims: model.RegisteredClaims{Subject: userID.String(), ID: "jti"},
+					SessionID:        uuid.Nil.String(),
+				}, nil)
+				ss.EXPECT().Validate(uuid.Nil).Return(errors.New("session has been revoked"))
+			},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedAuthenticate: `Bearer error="invalid_token", error_description="session has been revoked"`,
+			expectedRequestBody:  `{"message":"session has been revoked"}`,
 		},
 	}
 
@@ -110,9 +159,10 @@ func TestHandler_JWTAuthentication(t *testing.T) {
 			defer c.Finish()
 
 			user := mock_service.NewMockUserServicer(c)
-			test.mockBehavior(user, test.token)
+			session := mock_service.NewMockSessionServicer(c)
+			test.mockBehavior(user, session, test.token)
 
-			services := &service.Service{UserService: user}
+			services := &service.Service{UserService: user, SessionService: session}
 			handler := NewHandler(services)
 
 			e := echo.New()
@@ -131,7 +181,272 @@ func TestHandler_JWTAuthentication(t *testing.T) {
 			e.ServeHTTP(w, req)
 
 			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, test.expectedAuthenticate, w.Header().Get(echo.HeaderWWWAuthenticate))
 			assert.Equal(t, w.Body.String(), test.expectedRequestBody+"\n")
 		})
 	}
 }
+
+func TestHandler_RequireVerifiedEmail(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockEmailVerificationServicer, userID uuid.UUID, write bool)
+
+	tests := []struct {
+		name                string
+		method              string
+		write               bool
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:   "OK",
+			method: http.MethodPost,
+			write:  true,
+			mockBehavior: func(s *mock_service.MockEmailVerificationServicer, userID uuid.UUID, write bool) {
+				s.EXPECT().CheckAccess(userID, write).Return(nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"ok":true}`,
+		},
+		{
+			name:   "Read Request",
+			method: http.MethodGet,
+			write:  false,
+			mockBehavior: func(s *mock_service.MockEmailVerificationServicer, userID uuid.UUID, write bool) {
+				s.EXPECT().CheckAccess(userID, write).Return(nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"ok":true}`,
+		},
+		{
+			name:   "Unverified",
+			method: http.MethodPost,
+			write:  true,
+			mockBehavior: func(s *mock_service.MockEmailVerificationServicer, userID uuid.UUID, write bool) {
+				s.EXPECT().CheckAccess(userID, write).Return(errors.New("email address is not verified"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"email address is not verified"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			emailVerification := mock_service.NewMockEmailVerificationServicer(c)
+			test.mockBehavior(emailVerification, userID, test.write)
+
+			services := &service.Service{EmailVerificationService: emailVerification}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.Add(test.method, "/verified", handler.RequireVerifiedEmail(func(c echo.Context) error {
+				return c.JSON(http.StatusOK, echo.Map{
+					"ok": true,
+				})
+			}), func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					c.Set(ctxUserID, userID.String())
+					return next(c)
+				}
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, "/verified", nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+		})
+	}
+}
+
+func TestHandler_JWTAuthentication_AccessToken(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockAccessTokenServicer, token string)
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name                string
+		token               string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:  "OK",
+			token: "tdo_token",
+			mockBehavior: func(s *mock_service.MockAccessTokenServicer, token string) {
+				s.EXPECT().Authenticate(token).Return(&model.PersonalAccessToken{UserID: userID}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"userID":"` + userID.String() + `"}`,
+		},
+		{
+			name:  "Revoked Token",
+			token: "tdo_token",
+			mockBehavior: func(s *mock_service.MockAccessTokenServicer, token string) {
+				s.EXPECT().Authenticate(token).Return(nil, errors.New("access token has been revoked"))
+			},
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedRequestBody: `{"message":"access token has been revoked"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			accessToken := mock_service.NewMockAccessTokenServicer(c)
+			test.mockBehavior(accessToken, test.token)
+
+			services := &service.Service{AccessTokenService: accessToken}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.GET("/jwt", handler.JWTAuthentication(func(c echo.Context) error {
+				userID := c.Get(ctxUserID)
+
+				return c.JSON(http.StatusOK, echo.Map{
+					"userID": userID,
+				})
+			}))
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/jwt", nil)
+			req.Header.Add("Authorization", "Bearer "+test.token)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+		})
+	}
+}
+
+func TestHandler_RequireScope(t *testing.T) {
+	tests := []struct {
+		name                string
+		token               *model.PersonalAccessToken
+		scope               string
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:                "Session",
+			token:               nil,
+			scope:               model.ScopeListsWrite,
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"ok":true}`,
+		},
+		{
+			name:                "Granted Scope",
+			token:               &model.PersonalAccessToken{Scopes: []string{model.ScopeRead, model.ScopeListsWrite}},
+			scope:               model.ScopeListsWrite,
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"ok":true}`,
+		},
+		{
+			name:                "Missing Scope",
+			token:               &model.PersonalAccessToken{Scopes: []string{model.ScopeRead}},
+			scope:               model.ScopeItemsWrite,
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"access token lacks the items:write scope"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := NewHandler(&service.Service{})
+
+			e := echo.New()
+			e.POST("/scoped", func(c echo.Context) error {
+				return c.JSON(http.StatusOK, echo.Map{
+					"ok": true,
+				})
+			}, func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					if test.token != nil {
+						c.Set(ctxAccessToken, test.token)
+					}
+					return next(c)
+				}
+			}, handler.RequireScope(test.scope))
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/scoped", nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+		})
+	}
+}
+
+func TestHandler_RequireRole(t *testing.T) {
+	tests := []struct {
+		name                string
+		role                any
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:                "Admin",
+			role:                model.RoleAdmin,
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"ok":true}`,
+		},
+		{
+			name:                "Support",
+			role:                model.RoleSupport,
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"ok":true}`,
+		},
+		{
+			name:                "User",
+			role:                model.RoleUser,
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"insufficient role"}`,
+		},
+		{
+			name:                "Access Token",
+			role:                nil,
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"insufficient role"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := NewHandler(&service.Service{})
+
+			e := echo.New()
+			e.GET("/admin", func(c echo.Context) error {
+				return c.JSON(http.StatusOK, echo.Map{
+					"ok": true,
+				})
+			}, func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					if test.role != nil {
+						c.Set(ctxRole, test.role)
+					}
+					return next(c)
+				}
+			}, handler.RequireRole(model.RoleAdmin, model.RoleSupport))
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const (
+	oidcStateCookie       = "oidc_state"
+	oidcStateCookieMaxAge = 10 * time.Minute
+)
+
+// @Summary OpenID Connect Login
+// @Description Redirect to the identity provider to start an authorization code + PKCE sign-in
+// @Tags Auth
+// @Param provider path string true "Provider name"
+// @Success 302 "Found"
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /auth/oidc/{provider}/login [get]
+func (h *Handler) oidcLogin(c echo.Context) error {
+	provider := c.Param("provider")
+
+	login, err := h.OIDCService.Login(provider)
+	if err != nil {
+		if err.Error() == "unknown identity provider" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	c.SetCookie(newOIDCStateCookie(c, provider, login.StateToken, int(oidcStateCookieMaxAge.Seconds())))
+
+	return c.Redirect(http.StatusFound, login.URL)
+}
+
+// @Summary OpenID Connect Callback
+// @Description Finish the sign-in the identity provider redirected back from. The external identity
+// @Description is linked to the account with the same verified email, or a new account is created
+// @Tags Auth
+// @Produce json
+// @Param provider path string true "Provider name"
+// @Param code query string true "Authorization code"
+// @Param state query string true "State"
+// @Success 200 {object} signInResponse
+// @Failure 401 {object} swaggerErrorResponse
+// @Router /auth/oidc/{provider}/callback [get]
+func (h *Handler) oidcCallback(c echo.Context) error {
+	provider := c.Param("provider")
+
+	input := model.OIDCCallbackDTO{
+		Code:  c.QueryParam("code"),
+		State: c.QueryParam("state"),
+		Error: c.QueryParam("error"),
+	}
+
+	if cookie, err := c.Cookie(oidcStateCookie); err == nil {
+		input.StateToken = cookie.Value
+	}
+
+	// The state can only be used once, whatever the outcome
+	c.SetCookie(newOIDCStateCookie(c, provider, "", -1))
+
+	tokens, err := h.OIDCService.Callback(provider, input, getSessionMetadata(c))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	setAuditUser(c, tokens.UserID)
+
+	return c.JSON(http.StatusOK, newSignInResponse(tokens))
+}
+
+func newOIDCStateCookie(c echo.Context, provider, value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    value,
+		Path:     "/auth/oidc/" + provider,
+		MaxAge:   maxAge,
+		Secure:   c.Scheme() == "https",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
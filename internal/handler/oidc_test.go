@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_oidcLogin(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockOIDCServicer, provider string)
+
+	tests := []struct {
+		name                string
+		provider            string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedLocation    string
+		expectedCookie      string
+		expectedRequestBody string
+	}{
+		{
+			name:     "OK",
+			provider: "company",
+			mockBehavior: func(s *mock_service.MockOIDCServicer, provider string) {
+				s.EXPECT().Login(provider).Return(model.OIDCLogin{
+					URL:        "https://id.example.com/authorize?state=state",
+					StateToken: "state-token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusFound,
+			expectedLocation:    "https://id.example.com/authorize?state=state",
+			expectedCookie:      "oidc_state=state-token; Path=/auth/oidc/company; Max-Age=600; HttpOnly; SameSite=Lax",
+			expectedRequestBody: "",
+		},
+		{
+			name:     "Unknown Provider",
+			provider: "unknown",
+			mockBehavior: func(s *mock_service.MockOIDCServicer, provider string) {
+				s.EXPECT().Login(provider).Return(model.OIDCLogin{}, errors.New("unknown identity provider"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"unknown identity provider"}` + "\n",
+		},
+		{
+			name:     "Discovery Failure",
+			provider: "company",
+			mockBehavior: func(s *mock_service.MockOIDCServicer, provider string) {
+				s.EXPECT().Login(provider).Return(model.OIDCLogin{}, errors.New("discovery failed"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"discovery failed"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			oidc := mock_service.NewMockOIDCServicer(c)
+			test.mockBehavior(oidc, test.provider)
+
+			services := &service.Service{OIDCService: oidc}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.GET("/auth/oidc/:provider/login", handler.oidcLogin)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/auth/oidc/"+test.provider+"/login", nil)
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedLocation, w.Header().Get("Location"))
+			assert.Equal(t, test.expectedCookie, w.Header().Get("Set-Cookie"))
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_oidcCallback(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockOIDCServicer, input model.OIDCCallbackDTO)
+
+	tests := []struct {
+		name                string
+		query               string
+		cookie              string
+		inputData           model.OIDCCallbackDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			query:     "?code=code&state=state",
+			cookie:    "state-token",
+			inputData: model.OIDCCallbackDTO{Code: "code", State: "state", StateToken: "state-token"},
+			mockBehavior: func(s *mock_service.MockOIDCServicer, input model.OIDCCallbackDTO) {
+				s.EXPECT().Callback("company", input, testSessionMetadata).Return(model.Tokens{
+					AccessToken:  "test-token",
+					RefreshToken: "test-refresh-token",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"token":"test-token","refreshToken":"test-refresh-token"}`,
+		},
+		{
+			name:      "Provider Error",
+			query:     "?error=access_denied&state=state",
+			cookie:    "state-token",
+			inputData: model.OIDCCallbackDTO{State: "state", StateToken: "state-token", Error: "access_denied"},
+			mockBehavior: func(s *mock_service.MockOIDCServicer, input model.OIDCCallbackDTO) {
+				s.EXPECT().Callback("company", input, testSessionMetadata).Return(model.Tokens{},
+					errors.New("identity provider returned an error: access_denied"))
+			},
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedRequestBody: `{"message":"identity provider returned an error: access_denied"}`,
+		},
+		{
+			name:      "Missing State Cookie",
+			query:     "?code=code&state=state",
+			inputData: model.OIDCCallbackDTO{Code: "code", State: "state"},
+			mockBehavior: func(s *mock_service.MockOIDCServicer, input model.OIDCCallbackDTO) {
+				s.EXPECT().Callback("company", input, testSessionMetadata).Return(model.Tokens{},
+					errors.New("invalid state"))
+			},
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedRequestBody: `{"message":"invalid state"}`,
+		},
+		{
+			name:      "Unverified Account",
+			query:     "?code=code&state=state",
+			cookie:    "state-token",
+			inputData: model.OIDCCallbackDTO{Code: "code", State: "state", StateToken: "state-token"},
+			mockBehavior: func(s *mock_service.MockOIDCServicer, input model.OIDCCallbackDTO) {
+				s.EXPECT().Callback("company", input, testSessionMetadata).Return(model.Tokens{},
+					errors.New("an account with this email exists but its email is not verified"))
+			},
+			expectedStatusCode:  http.StatusUnauthorized,
+			expectedRequestBody: `{"message":"an account with this email exists but its email is not verified"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			oidc := mock_service.NewMockOIDCServicer(c)
+			test.mockBehavior(oidc, test.inputData)
+
+			services := &service.Service{OIDCService: oidc}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.GET("/auth/oidc/:provider/callback", handler.oidcCallback)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/auth/oidc/company/callback"+test.query, nil)
+			if test.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: oidcStateCookie, Value: test.cookie})
+			}
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody+"\n", w.Body.String())
+			assert.Equal(t, "oidc_state=; Path=/auth/oidc/company; Max-Age=0; HttpOnly; SameSite=Lax",
+				w.Header().Get("Set-Cookie"))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary Get my usage
+// @Description Get the plan of the current user, its quotas and how much of them is used. Items and members
+// @Description per list show the fullest list the user owns
+// @Tags Profile
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} model.Usage
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/me/usage [get]
+func (h *Handler) getMyUsage(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	usage, err := h.PlanService.GetUsage(userID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, usage)
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_getMyUsage(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockPlanServicer, userID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockPlanServicer, userID uuid.UUID) {
+				s.EXPECT().GetUsage(userID).Return(model.Usage{
+					Plan:           model.PlanFree,
+					Lists:          model.QuotaUsage{Used: 2, Limit: 5},
+					ItemsPerList:   model.QuotaUsage{Used: 40, Limit: 100},
+					MembersPerList: model.QuotaUsage{Used: 1, Limit: 3},
+					Attachments:    model.QuotaUsage{Used: 0, Limit: 10},
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"plan":"free","lists":{"used":2,"limit":5},"itemsPerList":{"used":40,"limit":100},"membersPerList":{"used":1,"limit":3},"attachments":{"used":0,"limit":10}}` + "\n",
+		},
+		{
+			name: "Not Found",
+			mockBehavior: func(s *mock_service.MockPlanServicer, userID uuid.UUID) {
+				s.EXPECT().GetUsage(userID).Return(model.Usage{}, errors.New("user not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"user not found"}`,
+		},
+		{
+			name: "Service Failure",
+			mockBehavior: func(s *mock_service.MockPlanServicer, userID uuid.UUID) {
+				s.EXPECT().GetUsage(userID).Return(model.Usage{}, errors.New("something went wrong"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"something went wrong"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			plan := mock_service.NewMockPlanServicer(c)
+			test.mockBehavior(plan, userID)
+
+			services := &service.Service{PlanService: plan}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/me/usage", nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.getMyUsage(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/pkg/jwk"
 )
 
 type resourceResponse struct {
@@ -14,6 +15,19 @@ type createResponse struct {
 	ID string `json:"id"`
 }
 
+type passwordPolicyResponse struct {
+	Message    string                    `json:"message"`
+	Violations []model.PasswordViolation `json:"violations"`
+}
+
 type swaggerErrorResponse struct {
 	Message string
 }
+
+type swaggerMessageResponse struct {
+	Message string `json:"message"`
+}
+
+type swaggerJWKSResponse struct {
+	Keys []jwk.Key `json:"keys"`
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary Get all sessions
+// @Description Get all active sessions of the current user
+// @Tags Sessions
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} resourceResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/me/sessions [get]
+func (h *Handler) getAllSessions(c echo.Context) error {
+	userID := getContextUserID(c)
+	sessionID := getContextSessionID(c)
+
+	sessions, err := h.SessionService.GetAll(userID, sessionID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(sessions),
+		Results:    sessions,
+		Pagination: nil,
+	})
+}
+
+// @Summary Revoke a session
+// @Description Sign out a single session of the current user
+// @Tags Sessions
+// @Produce json
+// @Security ApiKeyAuth
+// @Param sessionID path string true "Session ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Router /api/me/sessions/{sessionID} [delete]
+func (h *Handler) deleteSession(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	sessionID, err := getValueFromParams(c, "sessionID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.SessionService.RevokeByID(userID, sessionID); err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Revoke all sessions
+// @Description Sign out everywhere, including the current session
+// @Tags Sessions
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 204 "No Content"
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/me/sessions [delete]
+func (h *Handler) deleteAllSessions(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	if err := h.SessionService.RevokeAll(userID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
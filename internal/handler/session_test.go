@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_getAllSessions(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockSessionServicer, userID, sessionID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockSessionServicer, userID, sessionID uuid.UUID) {
+				s.EXPECT().GetAll(userID, sessionID).Return([]model.Session{
+					{
+						ID:         uuid.Nil,
+						CreatedAt:  time.Unix(0, 0).UTC(),
+						ExpiresAt:  time.Unix(0, 0).UTC(),
+						UserAgent:  "curl/8.0",
+						IP:         "192.0.2.1",
+						LastSeenAt: time.Unix(0, 0).UTC(),
+						Current:    true,
+					},
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"count":1,"results":[{"id":"00000000-0000-0000-0000-000000000000","createdAt":"1970-01-01T00:00:00Z","expiresAt":"1970-01-01T00:00:00Z","userAgent":"curl/8.0","ip":"192.0.2.1","lastSeenAt":"1970-01-01T00:00:00Z","current":true}],"pagination":null}` + "\n",
+		},
+		{
+			name: "Service Failure",
+			mockBehavior: func(s *mock_service.MockSessionServicer, userID, sessionID uuid.UUID) {
+				s.EXPECT().GetAll(userID, sessionID).Return(nil, errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+			sessionID := uuid.New()
+
+			session := mock_service.NewMockSessionServicer(c)
+			test.mockBehavior(session, userID, sessionID)
+
+			services := &service.Service{SessionService: session}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/sessions", nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.Set(ctxSessionID, sessionID)
+			err := handler.getAllSessions(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_deleteSession(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockSessionServicer, userID, sessionID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		sessionID           uuid.UUID
+		sessionIDStr        string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:         "OK",
+			sessionID:    uuid.Nil,
+			sessionIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockSessionServicer, userID, sessionID uuid.UUID) {
+				s.EXPECT().RevokeByID(userID, sessionID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			sessionID:           uuid.Nil,
+			sessionIDStr:        "12312312",
+			mockBehavior:        func(s *mock_service.MockSessionServicer, userID, sessionID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:         "Not Found",
+			sessionID:    uuid.Nil,
+			sessionIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockSessionServicer, userID, sessionID uuid.UUID) {
+				s.EXPECT().RevokeByID(userID, sessionID).Return(errors.New("session not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"session not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			session := mock_service.NewMockSessionServicer(c)
+			test.mockBehavior(session, userID, test.sessionID)
+
+			services := &service.Service{SessionService: session}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/sessions/%s", test.sessionIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("sessionID")
+			ctx.SetParamValues(test.sessionIDStr)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.deleteSession(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_deleteAllSessions(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockSessionServicer, userID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockSessionServicer, userID uuid.UUID) {
+				s.EXPECT().RevokeAll(userID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name: "Service Failure",
+			mockBehavior: func(s *mock_service.MockSessionServicer, userID uuid.UUID) {
+				s.EXPECT().RevokeAll(userID).Return(errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			session := mock_service.NewMockSessionServicer(c)
+			test.mockBehavior(session, userID)
+
+			services := &service.Service{SessionService: session}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/sessions", nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.deleteAllSessions(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
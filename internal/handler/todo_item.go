@@ -8,13 +8,16 @@ import (
 )
 
 // @Summary Delete an item
-// @Description Delete an item by its ID
+// @Description Move an item to the trash, where it can be restored until it is purged
 // @Tags Items
 // @Produce json
 // @Security ApiKeyAuth
 // @Param itemID path string true "Item ID"
 // @Success 204 "No Content"
 // @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
 // @Failure 500 {object} swaggerErrorResponse
 // @Router /api/lists/{listID}/items/{itemID} [delete]
 func (h *Handler) deleteItem(c echo.Context) error {
@@ -26,7 +29,16 @@ func (h *Handler) deleteItem(c echo.Context) error {
 	}
 
 	if err := h.TodoItemService.Delete(userID, itemID); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		switch err.Error() {
+		case "todo item not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "you do not have permission to change items of this list":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "todo list is archived":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
 	}
 
 	return c.NoContent(http.StatusNoContent)
@@ -42,6 +54,9 @@ func (h *Handler) deleteItem(c echo.Context) error {
 // @Param input body model.UpdateTodoItemDTO true "Updated item data"
 // @Success 200 "No Content"
 // @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
 // @Router /api/lists/{listID}/items/{itemID} [patch]
 func (h *Handler) updateItem(c echo.Context) error {
 	userID := getContextUserID(c)
@@ -57,7 +72,16 @@ func (h *Handler) updateItem(c echo.Context) error {
 	}
 
 	if err := h.TodoItemService.Update(userID, itemID, input); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		switch err.Error() {
+		case "todo item not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "you do not have permission to change items of this list":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "todo list is archived":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 	}
 
 	return c.NoContent(http.StatusOK)
@@ -143,6 +167,9 @@ func (h *Handler) getAllItems(c echo.Context) error {
 // @Param input body model.CreateTodoItemDTO true "New item data"
 // @Success 201 {object} createResponse
 // @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
 // @Router /api/lists/{listID}/items [post]
 func (h *Handler) createItem(c echo.Context) error {
 	userID := getContextUserID(c)
@@ -159,7 +186,16 @@ func (h *Handler) createItem(c echo.Context) error {
 
 	id, err := h.TodoItemService.Create(userID, listID, input)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		switch err.Error() {
+		case "todo list not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "you do not have permission to change items of this list":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "todo list is archived":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 	}
 
 	return c.JSON(http.StatusCreated, createResponse{ID: id.String()})
@@ -48,6 +48,26 @@ func TestHandler_deleteItem(t *testing.T) {
 			expectedStatusCode:  http.StatusBadRequest,
 			expectedRequestBody: `{"message":"invalid id"}`,
 		},
+		{
+			name:      "Forbidden",
+			itemID:    uuid.Nil,
+			itemIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoItemServicer, userID, itemID uuid.UUID) {
+				s.EXPECT().Delete(userID, itemID).Return(errors.New("you do not have permission to change items of this list"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"you do not have permission to change items of this list"}`,
+		},
+		{
+			name:      "Archived",
+			itemID:    uuid.Nil,
+			itemIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoItemServicer, userID, itemID uuid.UUID) {
+				s.EXPECT().Delete(userID, itemID).Return(errors.New("todo list is archived"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"todo list is archived"}`,
+		},
 		{
 			name:      "Service Failure",
 			itemID:    uuid.Nil,
@@ -156,6 +176,26 @@ func TestHandler_updateItem(t *testing.T) {
 			expectedStatusCode:  http.StatusBadRequest,
 			expectedRequestBody: `{"message":"Invalid JSON"}`,
 		},
+		{
+			name:      "Forbidden",
+			itemID:    uuid.Nil,
+			itemIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoItemServicer, userID, itemID uuid.UUID, input model.UpdateTodoItemDTO) {
+				s.EXPECT().Update(userID, itemID, input).Return(errors.New("you do not have permission to change items of this list"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"you do not have permission to change items of this list"}`,
+		},
+		{
+			name:      "Archived",
+			itemID:    uuid.Nil,
+			itemIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoItemServicer, userID, itemID uuid.UUID, input model.UpdateTodoItemDTO) {
+				s.EXPECT().Update(userID, itemID, input).Return(errors.New("todo list is archived"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"todo list is archived"}`,
+		},
 		{
 			name:      "Service Failure",
 			itemID:    uuid.Nil,
@@ -473,6 +513,22 @@ func TestHandler_createItem(t *testing.T) {
 			expectedStatusCode:  http.StatusBadRequest,
 			expectedRequestBody: `{"message":"Invalid JSON"}`,
 		},
+		{
+			name:      "Forbidden",
+			listID:    uuid.Nil,
+			listIDStr: uuid.Nil.String(),
+			inputBody: `{"title":"test", "description":"example", "deadline":"1970-01-01T00:00:00Z"}`,
+			inputData: model.CreateTodoItemDTO{
+				Title:       "test",
+				Description: "example",
+				Deadline:    time.Unix(0, 0).UTC(),
+			},
+			mockBehavior: func(s *mock_service.MockTodoItemServicer, userID, listID uuid.UUID, input model.CreateTodoItemDTO) {
+				s.EXPECT().Create(userID, listID, input).Return(uuid.Nil, errors.New("you do not have permission to change items of this list"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"you do not have permission to change items of this list"}`,
+		},
 		{
 			name:      "Service Failure",
 			listID:    uuid.Nil,
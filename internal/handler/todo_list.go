@@ -2,19 +2,22 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rtsoy/todo-app/internal/model"
 )
 
 // @Summary Delete a list
-// @Description Delete a list by its ID
+// @Description Move a list with its items to the trash, where it can be restored until it is purged
 // @Tags Lists
 // @Produce json
 // @Security ApiKeyAuth
 // @Param listID path string true "List ID"
 // @Success 204 "No Content"
 // @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
 // @Failure 500 {object} swaggerErrorResponse
 // @Router /api/lists/{listID} [delete]
 func (h *Handler) deleteList(c echo.Context) error {
@@ -26,7 +29,14 @@ func (h *Handler) deleteList(c echo.Context) error {
 	}
 
 	if err := h.TodoListService.Delete(userID, listID); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		switch err.Error() {
+		case "todo list not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can delete the list":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
 	}
 
 	return c.NoContent(http.StatusNoContent)
@@ -42,6 +52,9 @@ func (h *Handler) deleteList(c echo.Context) error {
 // @Param input body model.UpdateTodoListDTO true "Updated list data"
 // @Success 200 "No Content"
 // @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
 // @Router /api/lists/{listID} [patch]
 func (h *Handler) updateList(c echo.Context) error {
 	userID := getContextUserID(c)
@@ -57,7 +70,16 @@ func (h *Handler) updateList(c echo.Context) error {
 	}
 
 	if err := h.TodoListService.Update(userID, listID, input); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		switch err.Error() {
+		case "todo list not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "you do not have permission to change this list":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "todo list is archived":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 	}
 
 	return c.NoContent(http.StatusOK)
@@ -90,17 +112,27 @@ func (h *Handler) getListByID(c echo.Context) error {
 }
 
 // @Summary Get all lists
-// @Description Get all lists
+// @Description Get all active lists, or only the archived ones with archived=true
 // @Tags Lists
 // @Produce json
 // @Security ApiKeyAuth
 // @Param sort_by query string false "Sort lists by"
+// @Param archived query bool false "Get archived lists"
 // @Success 200 {object} resourceResponse
+// @Failure 400 {object} swaggerErrorResponse
 // @Failure 404 {object} swaggerErrorResponse
 // @Router /api/lists [get]
 func (h *Handler) getAllLists(c echo.Context) error {
 	userID := getContextUserID(c)
 
+	archived := false
+	if value := c.QueryParam("archived"); value != "" {
+		var err error
+		if archived, err = strconv.ParseBool(value); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid url query")
+		}
+	}
+
 	orderBy := c.QueryParam("sort_by")
 
 	orderByPtr := &orderBy
@@ -108,7 +140,7 @@ func (h *Handler) getAllLists(c echo.Context) error {
 		orderByPtr = nil
 	}
 
-	lists, err := h.TodoListService.GetAll(userID, orderByPtr)
+	lists, err := h.TodoListService.GetAll(userID, archived, orderByPtr)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, err.Error())
 	}
@@ -147,3 +179,77 @@ func (h *Handler) createList(c echo.Context) error {
 		"id": id,
 	})
 }
+
+// @Summary Archive a list
+// @Description Archive a list to hide it from the lists and make it read-only. Archived lists do not count
+// @Description towards the list quota. Only the owner can archive a list
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/archive [post]
+func (h *Handler) archiveList(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.TodoListService.Archive(userID, listID); err != nil {
+		switch err.Error() {
+		case "todo list not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can archive the list":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "todo list is already archived":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Unarchive a list
+// @Description Make an archived list active again. It counts towards the list quota of the owner again.
+// @Description Only the owner can unarchive a list
+// @Tags Lists
+// @Produce json
+// @Security ApiKeyAuth
+// @Param listID path string true "List ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 403 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
+// @Router /api/lists/{listID}/unarchive [post]
+func (h *Handler) unarchiveList(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	listID, err := getValueFromParams(c, "listID")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	if err := h.TodoListService.Unarchive(userID, listID); err != nil {
+		switch err.Error() {
+		case "todo list not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "only the owner can unarchive the list":
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		case "todo list is not archived", "exceeded the maximum allowed limit of existing lists":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -48,6 +48,26 @@ func TestHandler_deleteList(t *testing.T) {
 			expectedStatusCode:  http.StatusBadRequest,
 			expectedRequestBody: `{"message":"invalid id"}`,
 		},
+		{
+			name:      "Forbidden",
+			listID:    uuid.Nil,
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Delete(userID, listID).Return(errors.New("only the owner can delete the list"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"only the owner can delete the list"}`,
+		},
+		{
+			name:      "Not Found",
+			listID:    uuid.Nil,
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Delete(userID, listID).Return(errors.New("todo list not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"todo list not found"}`,
+		},
 		{
 			name:      "Service Failure",
 			listID:    uuid.Nil,
@@ -152,6 +172,26 @@ func TestHandler_updateList(t *testing.T) {
 			expectedStatusCode:  http.StatusBadRequest,
 			expectedRequestBody: `{"message":"invalid id"}`,
 		},
+		{
+			name:      "Forbidden",
+			listID:    uuid.Nil,
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.UpdateTodoListDTO) {
+				s.EXPECT().Update(userID, listID, input).Return(errors.New("you do not have permission to change this list"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"you do not have permission to change this list"}`,
+		},
+		{
+			name:      "Archived",
+			listID:    uuid.Nil,
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID, input model.UpdateTodoListDTO) {
+				s.EXPECT().Update(userID, listID, input).Return(errors.New("todo list is archived"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"todo list is archived"}`,
+		},
 		{
 			name:      "Service Failure",
 			listID:    uuid.Nil,
@@ -228,10 +268,11 @@ func TestHandler_getListByID(t *testing.T) {
 					Title:       "test",
 					Description: "example",
 					CreatedAt:   time.Unix(0, 0),
+					Role:        model.ListRoleOwner,
 				}, nil)
 			},
 			expectedStatusCode:  http.StatusOK,
-			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","title":"test","description":"example","createdAt":"1970-01-01T06:00:00+06:00"}`,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","title":"test","description":"example","createdAt":"1970-01-01T06:00:00+06:00","archivedAt":null,"role":"owner"}`,
 		},
 		{
 			name:                "Invalid ID",
@@ -300,8 +341,11 @@ func TestHandler_getListByID(t *testing.T) {
 func TestHandler_getAllLists(t *testing.T) {
 	type mockBehavior func(s *mock_service.MockTodoListServicer, userID uuid.UUID)
 
+	archivedAt := time.Unix(0, 0)
+
 	tests := []struct {
 		name                string
+		query               string
 		mockBehavior        mockBehavior
 		expectedStatusCode  int
 		expectedRequestBody string
@@ -309,28 +353,55 @@ func TestHandler_getAllLists(t *testing.T) {
 		{
 			name: "OK",
 			mockBehavior: func(s *mock_service.MockTodoListServicer, userID uuid.UUID) {
-				s.EXPECT().GetAll(userID, nil).Return([]model.TodoList{
+				s.EXPECT().GetAll(userID, false, nil).Return([]model.TodoList{
 					{
 						ID:          uuid.Nil,
 						Title:       "test1",
 						Description: "example",
 						CreatedAt:   time.Unix(0, 0),
+						Role:        model.ListRoleOwner,
 					},
 					{
 						ID:          uuid.Nil,
 						Title:       "test2",
 						Description: "example",
 						CreatedAt:   time.Unix(0, 0),
+						Role:        model.ListRoleOwner,
 					},
 				}, nil)
 			},
 			expectedStatusCode:  http.StatusOK,
-			expectedRequestBody: `{"count":2,"results":[{"id":"00000000-0000-0000-0000-000000000000","title":"test1","description":"example","createdAt":"1970-01-01T06:00:00+06:00"},{"id":"00000000-0000-0000-0000-000000000000","title":"test2","description":"example","createdAt":"1970-01-01T06:00:00+06:00"}],"pagination":null}`,
+			expectedRequestBody: `{"count":2,"results":[{"id":"00000000-0000-0000-0000-000000000000","title":"test1","description":"example","createdAt":"1970-01-01T06:00:00+06:00","archivedAt":null,"role":"owner"},{"id":"00000000-0000-0000-0000-000000000000","title":"test2","description":"example","createdAt":"1970-01-01T06:00:00+06:00","archivedAt":null,"role":"owner"}],"pagination":null}`,
+		},
+		{
+			name:  "Archived",
+			query: "?archived=true",
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID uuid.UUID) {
+				s.EXPECT().GetAll(userID, true, nil).Return([]model.TodoList{
+					{
+						ID:          uuid.Nil,
+						Title:       "test1",
+						Description: "example",
+						CreatedAt:   time.Unix(0, 0),
+						ArchivedAt:  &archivedAt,
+						Role:        model.ListRoleOwner,
+					},
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"count":1,"results":[{"id":"00000000-0000-0000-0000-000000000000","title":"test1","description":"example","createdAt":"1970-01-01T06:00:00+06:00","archivedAt":"1970-01-01T06:00:00+06:00","role":"owner"}],"pagination":null}`,
+		},
+		{
+			name:                "Invalid Query",
+			query:               "?archived=maybe",
+			mockBehavior:        func(s *mock_service.MockTodoListServicer, userID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid url query"}`,
 		},
 		{
 			name: "Service Failure",
 			mockBehavior: func(s *mock_service.MockTodoListServicer, userID uuid.UUID) {
-				s.EXPECT().GetAll(userID, nil).Return(nil, errors.New("service failure"))
+				s.EXPECT().GetAll(userID, false, nil).Return(nil, errors.New("service failure"))
 			},
 			expectedStatusCode:  http.StatusNotFound,
 			expectedRequestBody: `{"message":"service failure"}`,
@@ -354,7 +425,7 @@ func TestHandler_getAllLists(t *testing.T) {
 			e.GET("/get-all-lists", handler.getAllLists)
 
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodGet, "/get-all-lists", nil)
+			req := httptest.NewRequest(http.MethodGet, "/get-all-lists"+test.query, nil)
 			req.Header.Add("Content-Type", "application/json")
 
 			ctx := e.NewContext(req, w)
@@ -463,3 +534,204 @@ func TestHandler_createList(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_archiveList(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		listIDStr           string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Archive(userID, listID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			listIDStr:           "12312312",
+			mockBehavior:        func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:      "Not Found",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Archive(userID, listID).Return(errors.New("todo list not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"todo list not found"}`,
+		},
+		{
+			name:      "Forbidden",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Archive(userID, listID).Return(errors.New("only the owner can archive the list"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"only the owner can archive the list"}`,
+		},
+		{
+			name:      "Already Archived",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Archive(userID, listID).Return(errors.New("todo list is already archived"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"todo list is already archived"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			todoList := mock_service.NewMockTodoListServicer(c)
+			test.mockBehavior(todoList, userID, uuid.Nil)
+
+			services := &service.Service{TodoListService: todoList}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/lists/%s/archive", test.listIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("listID")
+			ctx.SetParamValues(test.listIDStr)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.archiveList(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_unarchiveList(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		listIDStr           string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Unarchive(userID, listID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			listIDStr:           "12312312",
+			mockBehavior:        func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:      "Not Owner",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Unarchive(userID, listID).Return(errors.New("only the owner can unarchive the list"))
+			},
+			expectedStatusCode:  http.StatusForbidden,
+			expectedRequestBody: `{"message":"only the owner can unarchive the list"}`,
+		},
+		{
+			name:      "Not Archived",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Unarchive(userID, listID).Return(errors.New("todo list is not archived"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"todo list is not archived"}`,
+		},
+		{
+			name:      "Quota Exceeded",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Unarchive(userID, listID).Return(errors.New("exceeded the maximum allowed limit of existing lists"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"exceeded the maximum allowed limit of existing lists"}`,
+		},
+		{
+			name:      "Service Failure",
+			listIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTodoListServicer, userID, listID uuid.UUID) {
+				s.EXPECT().Unarchive(userID, listID).Return(errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			todoList := mock_service.NewMockTodoListServicer(c)
+			test.mockBehavior(todoList, userID, uuid.Nil)
+
+			services := &service.Service{TodoListService: todoList}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/lists/%s/unarchive", test.listIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("listID")
+			ctx.SetParamValues(test.listIDStr)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.unarchiveList(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// @Summary Get the trash
+// @Description Get the deleted lists the current user owns and the deleted items of the lists they can edit,
+// @Description most recently deleted first. Entries are removed for good at purgeAt
+// @Tags Trash
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} resourceResponse
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/trash [get]
+func (h *Handler) getTrash(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	entries, err := h.TrashService.GetAll(userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, resourceResponse{
+		Count:      len(entries),
+		Results:    entries,
+		Pagination: nil,
+	})
+}
+
+// @Summary Restore from the trash
+// @Description Restore a deleted list with its items, or a deleted item into its list. Restored lists and
+// @Description items count towards the quotas again
+// @Tags Trash
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "List or item ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 404 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
+// @Router /api/trash/{id}/restore [post]
+func (h *Handler) restoreTrashEntry(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	entryID, err := getValueFromParams(c, "id")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	setAuditTarget(c, "trash_entry", entryID.String())
+
+	if err := h.TrashService.Restore(userID, entryID); err != nil {
+		switch err.Error() {
+		case "trash entry not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "todo list is archived", "exceeded the maximum allowed limit of existing lists",
+			"exceeded the maximum allowed number of items in the list":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		default:
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
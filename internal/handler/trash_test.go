@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_getTrash(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTrashServicer, userID uuid.UUID)
+
+	listID := uuid.Nil
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockTrashServicer, userID uuid.UUID) {
+				s.EXPECT().GetAll(userID).Return([]model.TrashEntry{
+					{
+						ID:        uuid.Nil,
+						Type:      model.TrashTypeItem,
+						Title:     "item",
+						ListID:    &listID,
+						DeletedAt: time.Unix(0, 0).UTC(),
+						PurgeAt:   time.Unix(0, 0).UTC().Add(720 * time.Hour),
+					},
+					{
+						ID:        uuid.Nil,
+						Type:      model.TrashTypeList,
+						Title:     "list",
+						DeletedAt: time.Unix(0, 0).UTC(),
+						PurgeAt:   time.Unix(0, 0).UTC().Add(720 * time.Hour),
+					},
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"count":2,"results":[{"id":"00000000-0000-0000-0000-000000000000","type":"item","title":"item","listId":"00000000-0000-0000-0000-000000000000","deletedAt":"1970-01-01T00:00:00Z","purgeAt":"1970-01-31T00:00:00Z"},{"id":"00000000-0000-0000-0000-000000000000","type":"list","title":"list","deletedAt":"1970-01-01T00:00:00Z","purgeAt":"1970-01-31T00:00:00Z"}],"pagination":null}` + "\n",
+		},
+		{
+			name: "Empty",
+			mockBehavior: func(s *mock_service.MockTrashServicer, userID uuid.UUID) {
+				s.EXPECT().GetAll(userID).Return([]model.TrashEntry{}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"count":0,"results":[],"pagination":null}` + "\n",
+		},
+		{
+			name: "Service Failure",
+			mockBehavior: func(s *mock_service.MockTrashServicer, userID uuid.UUID) {
+				s.EXPECT().GetAll(userID).Return(nil, errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			trash := mock_service.NewMockTrashServicer(c)
+			test.mockBehavior(trash, userID)
+
+			services := &service.Service{TrashService: trash}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/trash", nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.getTrash(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_restoreTrashEntry(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTrashServicer, userID, entryID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		entryIDStr          string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:       "OK",
+			entryIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTrashServicer, userID, entryID uuid.UUID) {
+				s.EXPECT().Restore(userID, entryID).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid ID",
+			entryIDStr:          "12312312",
+			mockBehavior:        func(s *mock_service.MockTrashServicer, userID, entryID uuid.UUID) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid id"}`,
+		},
+		{
+			name:       "Not Found",
+			entryIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTrashServicer, userID, entryID uuid.UUID) {
+				s.EXPECT().Restore(userID, entryID).Return(errors.New("trash entry not found"))
+			},
+			expectedStatusCode:  http.StatusNotFound,
+			expectedRequestBody: `{"message":"trash entry not found"}`,
+		},
+		{
+			name:       "Archived List",
+			entryIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTrashServicer, userID, entryID uuid.UUID) {
+				s.EXPECT().Restore(userID, entryID).Return(errors.New("todo list is archived"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"todo list is archived"}`,
+		},
+		{
+			name:       "Quota Exceeded",
+			entryIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTrashServicer, userID, entryID uuid.UUID) {
+				s.EXPECT().Restore(userID, entryID).Return(errors.New("exceeded the maximum allowed limit of existing lists"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"exceeded the maximum allowed limit of existing lists"}`,
+		},
+		{
+			name:       "Service Failure",
+			entryIDStr: uuid.Nil.String(),
+			mockBehavior: func(s *mock_service.MockTrashServicer, userID, entryID uuid.UUID) {
+				s.EXPECT().Restore(userID, entryID).Return(errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			trash := mock_service.NewMockTrashServicer(c)
+			test.mockBehavior(trash, userID, uuid.Nil)
+
+			services := &service.Service{TrashService: trash}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/trash/%s/restore", test.entryIDStr), nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(test.entryIDStr)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.restoreTrashEntry(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
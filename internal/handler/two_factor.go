@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+type recoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// @Summary Start two-factor enrolment
+// @Description Generate a TOTP secret and otpauth URI for the authenticator app
+// @Tags Two-Factor
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} model.TwoFactorEnrollment
+// @Failure 400 {object} swaggerErrorResponse
+// @Router /api/me/2fa [post]
+func (h *Handler) enrollTwoFactor(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	enrollment, err := h.TwoFactorService.Enroll(userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, enrollment)
+}
+
+// @Summary Confirm two-factor enrolment
+// @Description Enable two-factor authentication with a first code and get the recovery codes
+// @Tags Two-Factor
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param input body model.TwoFactorCodeDTO true "Code from the authenticator app"
+// @Success 200 {object} recoveryCodesResponse
+// @Failure 400 {object} swaggerErrorResponse
+// @Router /api/me/2fa/confirm [post]
+func (h *Handler) confirmTwoFactor(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	var input model.TwoFactorCodeDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	codes, err := h.TwoFactorService.Confirm(userID, input)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, recoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// @Summary Disable two-factor authentication
+// @Description Disable two-factor authentication using a TOTP or recovery code
+// @Tags Two-Factor
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param input body model.TwoFactorCodeDTO true "TOTP or recovery code"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 429 {object} swaggerErrorResponse
+// @Router /api/me/2fa/disable [post]
+func (h *Handler) disableTwoFactor(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	var input model.TwoFactorCodeDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	if err := h.TwoFactorService.Disable(userID, input, getSessionMetadata(c)); err != nil {
+		var lockedOutErr *model.LockedOutError
+		if errors.As(err, &lockedOutErr) {
+			return lockedOut(c, lockedOutErr)
+		}
+
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_enrollTwoFactor(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID) {
+				s.EXPECT().Enroll(userID).Return(model.TwoFactorEnrollment{
+					Secret: "JBSWY3DPEHPK3PXP",
+					URI:    "otpauth://totp/TodoApp:test@example.com?secret=JBSWY3DPEHPK3PXP",
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"secret":"JBSWY3DPEHPK3PXP","uri":"otpauth://totp/TodoApp:test@example.com?secret=JBSWY3DPEHPK3PXP"}` + "\n",
+		},
+		{
+			name: "Already Enabled",
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID) {
+				s.EXPECT().Enroll(userID).Return(model.TwoFactorEnrollment{}, errors.New("two-factor authentication is already enabled"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"two-factor authentication is already enabled"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			twoFactor := mock_service.NewMockTwoFactorServicer(c)
+			test.mockBehavior(twoFactor, userID)
+
+			services := &service.Service{TwoFactorService: twoFactor}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/2fa", nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.enrollTwoFactor(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_confirmTwoFactor(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID, input model.TwoFactorCodeDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.TwoFactorCodeDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"code": "123456"}`,
+			inputData: model.TwoFactorCodeDTO{Code: "123456"},
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID, input model.TwoFactorCodeDTO) {
+				s.EXPECT().Confirm(userID, input).Return([]string{"abcd-efgh-ijkl-mnop"}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"recoveryCodes":["abcd-efgh-ijkl-mnop"]}` + "\n",
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID, input model.TwoFactorCodeDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "Invalid Code",
+			inputBody: `{"code": "000000"}`,
+			inputData: model.TwoFactorCodeDTO{Code: "000000"},
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID, input model.TwoFactorCodeDTO) {
+				s.EXPECT().Confirm(userID, input).Return(nil, errors.New("invalid two-factor code"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid two-factor code"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			twoFactor := mock_service.NewMockTwoFactorServicer(c)
+			test.mockBehavior(twoFactor, userID, test.inputData)
+
+			services := &service.Service{TwoFactorService: twoFactor}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/2fa/confirm", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.confirmTwoFactor(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_disableTwoFactor(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID, input model.TwoFactorCodeDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.TwoFactorCodeDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+		expectedRetryAfter  string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"code": "123456"}`,
+			inputData: model.TwoFactorCodeDTO{Code: "123456"},
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID, input model.TwoFactorCodeDTO) {
+				s.EXPECT().Disable(userID, input, testSessionMetadata).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID, input model.TwoFactorCodeDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}` + "\n",
+		},
+		{
+			name:      "Invalid Code",
+			inputBody: `{"code": "000000"}`,
+			inputData: model.TwoFactorCodeDTO{Code: "000000"},
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID, input model.TwoFactorCodeDTO) {
+				s.EXPECT().Disable(userID, input, testSessionMetadata).Return(errors.New("invalid two-factor code"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"invalid two-factor code"}` + "\n",
+		},
+		{
+			name:      "Locked Out",
+			inputBody: `{"code": "000000"}`,
+			inputData: model.TwoFactorCodeDTO{Code: "000000"},
+			mockBehavior: func(s *mock_service.MockTwoFactorServicer, userID uuid.UUID, input model.TwoFactorCodeDTO) {
+				s.EXPECT().Disable(userID, input, testSessionMetadata).Return(
+					&model.LockedOutError{RetryAfter: 90*time.Second + time.Millisecond})
+			},
+			expectedStatusCode:  http.StatusTooManyRequests,
+			expectedRequestBody: `{"message":"too many failed sign-in attempts, try again later"}` + "\n",
+			expectedRetryAfter:  "91",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			twoFactor := mock_service.NewMockTwoFactorServicer(c)
+			test.mockBehavior(twoFactor, userID, test.inputData)
+
+			services := &service.Service{TwoFactorService: twoFactor}
+			handler := NewHandler(services)
+
+			e := echo.New()
+			e.POST("/api/me/2fa/disable", handler.disableTwoFactor, func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					c.Set(ctxUserID, userID.String())
+					return next(c)
+				}
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/api/me/2fa/disable", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			e.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+			assert.Equal(t, test.expectedRetryAfter, w.Header().Get(echo.HeaderRetryAfter))
+		})
+	}
+}
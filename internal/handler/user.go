@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// @Summary Get profile
+// @Description Get the account of the current user
+// @Tags Profile
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} model.User
+// @Failure 500 {object} swaggerErrorResponse
+// @Router /api/me [get]
+func (h *Handler) getMe(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	user, err := h.UserService.GetByID(userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// @Summary Update profile
+// @Description Change the username and/or the email address. A new email address gets a verification
+// @Description link and only replaces the current one once the link is opened
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param input body model.UpdateUserDTO true "Fields to change"
+// @Success 200 {object} model.User
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 409 {object} swaggerErrorResponse
+// @Router /api/me [patch]
+func (h *Handler) updateMe(c echo.Context) error {
+	userID := getContextUserID(c)
+
+	var input model.UpdateUserDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	user, err := h.UserService.Update(userID, input)
+	if err != nil {
+		if err.Error() == "username is already taken" || err.Error() == "email is already taken" {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// @Summary Change password
+// @Description Set a new password. Every other session of the user is signed out. Accounts without
+// @Description a password may set one without the current password within a few minutes of signing in
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param input body model.ChangePasswordDTO true "Current and new password"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 429 {object} swaggerErrorResponse
+// @Router /api/me/password [post]
+func (h *Handler) changePassword(c echo.Context) error {
+	userID := getContextUserID(c)
+	sessionID := getContextSessionID(c)
+
+	var input model.ChangePasswordDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	if err := h.UserService.ChangePassword(userID, sessionID, input, getSessionMetadata(c)); err != nil {
+		var lockedOutErr *model.LockedOutError
+		if errors.As(err, &lockedOutErr) {
+			return lockedOut(c, lockedOutErr)
+		}
+
+		return passwordError(err, http.StatusBadRequest)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// @Summary Delete account
+// @Description Permanently delete the account with all its lists and items, sessions, access tokens,
+// @Description linked identities and pending reset or verification tokens. Accounts without a password
+// @Description have to have signed in within the last few minutes
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param input body model.DeleteUserDTO true "Current password"
+// @Success 204 "No Content"
+// @Failure 400 {object} swaggerErrorResponse
+// @Failure 429 {object} swaggerErrorResponse
+// @Router /api/me [delete]
+func (h *Handler) deleteMe(c echo.Context) error {
+	userID := getContextUserID(c)
+	sessionID := getContextSessionID(c)
+
+	var input model.DeleteUserDTO
+	if err := c.Bind(&input); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid JSON")
+	}
+
+	if err := h.UserService.Delete(userID, sessionID, input, getSessionMetadata(c)); err != nil {
+		var lockedOutErr *model.LockedOutError
+		if errors.As(err, &lockedOutErr) {
+			return lockedOut(c, lockedOutErr)
+		}
+
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,379 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/service"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_getMe(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockUserServicer, userID uuid.UUID)
+
+	tests := []struct {
+		name                string
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name: "OK",
+			mockBehavior: func(s *mock_service.MockUserServicer, userID uuid.UUID) {
+				s.EXPECT().GetByID(userID).Return(&model.User{
+					ID:           uuid.Nil,
+					Email:        "test@example.com",
+					Username:     "test",
+					PasswordHash: "hash",
+					Role:         model.RoleUser,
+					Plan:         model.PlanFree,
+					CreatedAt:    time.Unix(0, 0).UTC(),
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","email":"test@example.com","username":"test","verifiedAt":null,"role":"user","plan":"free","disabledAt":null,"createdAt":"1970-01-01T00:00:00Z"}` + "\n",
+		},
+		{
+			name: "Service Failure",
+			mockBehavior: func(s *mock_service.MockUserServicer, userID uuid.UUID) {
+				s.EXPECT().GetByID(userID).Return(nil, errors.New("service failure"))
+			},
+			expectedStatusCode:  http.StatusInternalServerError,
+			expectedRequestBody: `{"message":"service failure"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			user := mock_service.NewMockUserServicer(c)
+			test.mockBehavior(user, userID)
+
+			services := &service.Service{UserService: user}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.getMe(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_updateMe(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockUserServicer, userID uuid.UUID, input model.UpdateUserDTO)
+
+	username := "renamed"
+	email := "new@example.com"
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.UpdateUserDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"username": "renamed", "email": "new@example.com"}`,
+			inputData: model.UpdateUserDTO{Username: &username, Email: &email},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID uuid.UUID, input model.UpdateUserDTO) {
+				s.EXPECT().Update(userID, input).Return(&model.User{
+					ID:        uuid.Nil,
+					Email:     "test@example.com",
+					Username:  "renamed",
+					Role:      model.RoleUser,
+					Plan:      model.PlanFree,
+					CreatedAt: time.Unix(0, 0).UTC(),
+				}, nil)
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedRequestBody: `{"id":"00000000-0000-0000-0000-000000000000","email":"test@example.com","username":"renamed","verifiedAt":null,"role":"user","plan":"free","disabledAt":null,"createdAt":"1970-01-01T00:00:00Z"}` + "\n",
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockUserServicer, userID uuid.UUID, input model.UpdateUserDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "Invalid Username",
+			inputBody: `{"username": "renamed"}`,
+			inputData: model.UpdateUserDTO{Username: &username},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID uuid.UUID, input model.UpdateUserDTO) {
+				s.EXPECT().Update(userID, input).Return(nil, errors.New("username is not valid"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"username is not valid"}`,
+		},
+		{
+			name:      "Email Taken",
+			inputBody: `{"email": "new@example.com"}`,
+			inputData: model.UpdateUserDTO{Email: &email},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID uuid.UUID, input model.UpdateUserDTO) {
+				s.EXPECT().Update(userID, input).Return(nil, errors.New("email is already taken"))
+			},
+			expectedStatusCode:  http.StatusConflict,
+			expectedRequestBody: `{"message":"email is already taken"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+
+			user := mock_service.NewMockUserServicer(c)
+			test.mockBehavior(user, userID, test.inputData)
+
+			services := &service.Service{UserService: user}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPatch, "/me", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			err := handler.updateMe(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_changePassword(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.ChangePasswordDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.ChangePasswordDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"currentPassword": "qwerty123", "newPassword": "qwerty1234"}`,
+			inputData: model.ChangePasswordDTO{CurrentPassword: "qwerty123", NewPassword: "qwerty1234"},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.ChangePasswordDTO) {
+				s.EXPECT().ChangePassword(userID, sessionID, input, testSessionMetadata).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:                "Invalid JSON",
+			inputBody:           `{`,
+			mockBehavior:        func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.ChangePasswordDTO) {},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"Invalid JSON"}`,
+		},
+		{
+			name:      "Wrong Password",
+			inputBody: `{"currentPassword": "wrong", "newPassword": "qwerty1234"}`,
+			inputData: model.ChangePasswordDTO{CurrentPassword: "wrong", NewPassword: "qwerty1234"},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.ChangePasswordDTO) {
+				s.EXPECT().ChangePassword(userID, sessionID, input, testSessionMetadata).Return(errors.New("wrong password"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"wrong password"}`,
+		},
+		{
+			name:      "Locked Out",
+			inputBody: `{"currentPassword": "wrong", "newPassword": "qwerty1234"}`,
+			inputData: model.ChangePasswordDTO{CurrentPassword: "wrong", NewPassword: "qwerty1234"},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.ChangePasswordDTO) {
+				s.EXPECT().ChangePassword(userID, sessionID, input, testSessionMetadata).Return(
+					&model.LockedOutError{RetryAfter: time.Minute})
+			},
+			expectedStatusCode:  http.StatusTooManyRequests,
+			expectedRequestBody: `{"message":"too many failed sign-in attempts, try again later"}`,
+		},
+		{
+			name:      "Sign In Required",
+			inputBody: `{"newPassword": "qwerty1234"}`,
+			inputData: model.ChangePasswordDTO{NewPassword: "qwerty1234"},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.ChangePasswordDTO) {
+				s.EXPECT().ChangePassword(userID, sessionID, input, testSessionMetadata).Return(
+					errors.New("sign in again to confirm this action"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"sign in again to confirm this action"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+			sessionID := uuid.New()
+
+			user := mock_service.NewMockUserServicer(c)
+			test.mockBehavior(user, userID, sessionID, test.inputData)
+
+			services := &service.Service{UserService: user}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/me/password", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.Set(ctxSessionID, sessionID)
+			err := handler.changePassword(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_deleteMe(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.DeleteUserDTO)
+
+	tests := []struct {
+		name                string
+		inputBody           string
+		inputData           model.DeleteUserDTO
+		mockBehavior        mockBehavior
+		expectedStatusCode  int
+		expectedRequestBody string
+	}{
+		{
+			name:      "OK",
+			inputBody: `{"password": "qwerty123"}`,
+			inputData: model.DeleteUserDTO{Password: "qwerty123"},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.DeleteUserDTO) {
+				s.EXPECT().Delete(userID, sessionID, input, testSessionMetadata).Return(nil)
+			},
+			expectedStatusCode:  http.StatusNoContent,
+			expectedRequestBody: "",
+		},
+		{
+			name:      "Wrong Password",
+			inputBody: `{"password": "wrong"}`,
+			inputData: model.DeleteUserDTO{Password: "wrong"},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.DeleteUserDTO) {
+				s.EXPECT().Delete(userID, sessionID, input, testSessionMetadata).Return(errors.New("wrong password"))
+			},
+			expectedStatusCode:  http.StatusBadRequest,
+			expectedRequestBody: `{"message":"wrong password"}`,
+		},
+		{
+			name:      "Locked Out",
+			inputBody: `{"password": "wrong"}`,
+			inputData: model.DeleteUserDTO{Password: "wrong"},
+			mockBehavior: func(s *mock_service.MockUserServicer, userID, sessionID uuid.UUID, input model.DeleteUserDTO) {
+				s.EXPECT().Delete(userID, sessionID, input, testSessionMetadata).Return(
+					&model.LockedOutError{RetryAfter: time.Minute})
+			},
+			expectedStatusCode:  http.StatusTooManyRequests,
+			expectedRequestBody: `{"message":"too many failed sign-in attempts, try again later"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			userID := uuid.New()
+			sessionID := uuid.New()
+
+			user := mock_service.NewMockUserServicer(c)
+			test.mockBehavior(user, userID, sessionID, test.inputData)
+
+			services := &service.Service{UserService: user}
+			handler := NewHandler(services)
+
+			e := echo.New()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, "/me", bytes.NewBufferString(test.inputBody))
+			req.Header.Add("Content-Type", "application/json")
+
+			ctx := e.NewContext(req, w)
+
+			ctx.Set(ctxUserID, userID.String())
+			ctx.Set(ctxSessionID, sessionID)
+			err := handler.deleteMe(ctx)
+			if err != nil {
+				httpErr := err.(*echo.HTTPError)
+
+				errBytes, _ := json.Marshal(err)
+				errJSON := string(errBytes)
+
+				assert.Equal(t, test.expectedStatusCode, httpErr.Code)
+				assert.Equal(t, test.expectedRequestBody, errJSON)
+				return
+			}
+
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+			assert.Equal(t, test.expectedRequestBody, w.Body.String())
+		})
+	}
+}
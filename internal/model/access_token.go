@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Scopes a personal access token can be granted
+const (
+	ScopeRead       = "read"
+	ScopeListsWrite = "lists:write"
+	ScopeItemsWrite = "items:write"
+)
+
+var Scopes = []string{ScopeRead, ScopeListsWrite, ScopeItemsWrite}
+
+type PersonalAccessToken struct {
+	ID         uuid.UUID      `json:"id"`
+	UserID     uuid.UUID      `json:"-" db:"user_id"`
+	Name       string         `json:"name"`
+	TokenHash  string         `json:"-" db:"token_hash"`
+	Scopes     pq.StringArray `json:"scopes" swaggertype:"array,string"`
+	CreatedAt  time.Time      `json:"createdAt" db:"created_at"`
+	ExpiresAt  *time.Time     `json:"expiresAt" db:"expires_at"`
+	LastUsedAt *time.Time     `json:"lastUsedAt" db:"last_used_at"`
+	RevokedAt  *time.Time     `json:"-" db:"revoked_at"`
+}
+
+// HasScope reports whether the token was granted the scope
+func (t PersonalAccessToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+type CreatePersonalAccessTokenDTO struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// CreatedPersonalAccessToken carries the plain token, which is only returned once
+type CreatedPersonalAccessToken struct {
+	PersonalAccessToken
+	Token string `json:"token"`
+}
@@ -0,0 +1,18 @@
+package model
+
+type SetRoleDTO struct {
+	Role string `json:"role"`
+}
+
+// UsageStats is an overview of the whole installation for the admin API.
+type UsageStats struct {
+	Users          int `json:"users" db:"users"`
+	VerifiedUsers  int `json:"verifiedUsers" db:"verified_users"`
+	DisabledUsers  int `json:"disabledUsers" db:"disabled_users"`
+	TwoFactorUsers int `json:"twoFactorUsers" db:"two_factor_users"`
+	NewUsers       int `json:"newUsersLast30Days" db:"new_users"`
+	ActiveSessions int `json:"activeSessions" db:"active_sessions"`
+	Lists          int `json:"lists" db:"lists"`
+	Items          int `json:"items" db:"items"`
+	CompletedItems int `json:"completedItems" db:"completed_items"`
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audited actions
+const (
+	AuditSignUp               = "auth.sign_up"
+	AuditSignIn               = "auth.sign_in"
+	AuditSignInTwoFactor      = "auth.sign_in_2fa"
+	AuditSignInOIDC           = "auth.sign_in_oidc"
+	AuditPasswordResetRequest = "auth.password_reset_request"
+	AuditPasswordReset        = "auth.password_reset"
+
+	AuditAccountUpdate     = "account.update"
+	AuditAccountDelete     = "account.delete"
+	AuditPasswordChange    = "account.password_change"
+	AuditDataExport        = "account.data_export"
+	AuditSessionRevoke     = "account.session_revoke"
+	AuditSessionRevokeAll  = "account.session_revoke_all"
+	AuditTwoFactorEnable   = "account.2fa_enable"
+	AuditTwoFactorDisable  = "account.2fa_disable"
+	AuditAccessTokenCreate = "account.access_token_create"
+	AuditAccessTokenRevoke = "account.access_token_revoke"
+
+	AuditListDelete          = "list.delete"
+	AuditListArchive         = "list.archive"
+	AuditListUnarchive       = "list.unarchive"
+	AuditListMemberAdd       = "list.member_add"
+	AuditListMemberRole      = "list.member_role_change"
+	AuditListMemberRemove    = "list.member_remove"
+	AuditListInviteCreate    = "list.invite_create"
+	AuditListInviteRevoke    = "list.invite_revoke"
+	AuditListInviteAccept    = "list.invite_accept"
+	AuditListTransferRequest = "list.transfer_request"
+	AuditListTransferCancel  = "list.transfer_cancel"
+	AuditListTransferAccept  = "list.transfer_accept"
+	AuditListTransferDecline = "list.transfer_decline"
+	AuditItemDelete          = "item.delete"
+	AuditTrashRestore        = "trash.restore"
+
+	AuditAdminSignInUnlock     = "admin.sign_in_unlock"
+	AuditAdminRoleChange       = "admin.role_change"
+	AuditAdminPlanChange       = "admin.plan_change"
+	AuditAdminUserDisable      = "admin.user_disable"
+	AuditAdminUserEnable       = "admin.user_enable"
+	AuditAdminTwoFactorReset   = "admin.2fa_reset"
+	AuditAdminInvitationCreate = "admin.invitation_create"
+	AuditAdminInvitationRevoke = "admin.invitation_revoke"
+)
+
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+// AuditTargetUser and AuditTargetLogin are the target types that name an account.
+const (
+	AuditTargetUser  = "user"
+	AuditTargetLogin = "login"
+)
+
+// AuditEvent records who did what to which target and whether it worked. UserID is the
+// account the event concerns, the actor's own account unless the actor acted on another one.
+type AuditEvent struct {
+	ID         uuid.UUID  `json:"id"`
+	ActorID    *uuid.UUID `json:"actorId" db:"actor_id"`
+	UserID     *uuid.UUID `json:"userId" db:"user_id"`
+	Action     string     `json:"action"`
+	TargetType string     `json:"targetType" db:"target_type"`
+	TargetID   string     `json:"targetId" db:"target_id"`
+	IP         string     `json:"ip"`
+	UserAgent  string     `json:"userAgent" db:"user_agent"`
+	Outcome    string     `json:"outcome"`
+	Reason     string     `json:"reason"`
+	CreatedAt  time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// AuditFilterDTO narrows the admin audit query, every field is optional. Times are RFC 3339.
+type AuditFilterDTO struct {
+	ActorID string `query:"actorId"`
+	UserID  string `query:"userId"`
+	Action  string `query:"action"`
+	Outcome string `query:"outcome"`
+	From    string `query:"from"`
+	To      string `query:"to"`
+}
+
+type AuditFilter struct {
+	ActorID *uuid.UUID
+	UserID  *uuid.UUID
+	Action  string
+	Outcome string
+	From    *time.Time
+	To      *time.Time
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DataExportPending = "pending"
+	DataExportReady   = "ready"
+	DataExportFailed  = "failed"
+)
+
+// DataExport is a zip archive with everything a user has stored. It is built in the
+// background and can be downloaded through DownloadURL until it expires.
+type DataExport struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"-" db:"user_id"`
+	Status      string     `json:"status"`
+	Archive     []byte     `json:"-"`
+	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+	CompletedAt *time.Time `json:"completedAt" db:"completed_at"`
+	ExpiresAt   *time.Time `json:"expiresAt" db:"expires_at"`
+	DownloadURL string     `json:"downloadUrl,omitempty" db:"-"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Registration modes
+const (
+	RegistrationOpen     = "open"
+	RegistrationInvite   = "invite"
+	RegistrationDomains  = "domains"
+	RegistrationDisabled = "disabled"
+)
+
+// Invitation lets up to MaxUses people sign up while registration is invite-only.
+type Invitation struct {
+	ID        uuid.UUID  `json:"id"`
+	CodeHash  string     `json:"-" db:"code_hash"`
+	CreatedBy *uuid.UUID `json:"createdBy" db:"created_by"`
+	MaxUses   int        `json:"maxUses" db:"max_uses"`
+	Uses      int        `json:"uses"`
+	CreatedAt time.Time  `json:"createdAt" db:"created_at"`
+	ExpiresAt *time.Time `json:"expiresAt" db:"expires_at"`
+	RevokedAt *time.Time `json:"-" db:"revoked_at"`
+}
+
+type CreateInvitationDTO struct {
+	MaxUses   int        `json:"maxUses"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// CreatedInvitation carries the plain code, which is only returned once
+type CreatedInvitation struct {
+	Invitation
+	Code string `json:"code"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListInvite lets up to MaxUses signed-in users join a list with Role by following a link.
+type ListInvite struct {
+	ID        uuid.UUID  `json:"id"`
+	ListID    uuid.UUID  `json:"listId" db:"list_id"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	Role      string     `json:"role"`
+	CreatedBy *uuid.UUID `json:"createdBy" db:"created_by"`
+	MaxUses   int        `json:"maxUses" db:"max_uses"`
+	Uses      int        `json:"uses"`
+	CreatedAt time.Time  `json:"createdAt" db:"created_at"`
+	ExpiresAt *time.Time `json:"expiresAt" db:"expires_at"`
+	RevokedAt *time.Time `json:"-" db:"revoked_at"`
+}
+
+// CreateListInviteDTO Role defaults to editor and MaxUses to 1.
+type CreateListInviteDTO struct {
+	Role      string     `json:"role"`
+	MaxUses   int        `json:"maxUses"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// CreatedListInvite carries the plain token and the link built from it, which are only returned once
+type CreatedListInvite struct {
+	ListInvite
+	Token string `json:"token"`
+	Link  string `json:"link"`
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Roles on a todo list, from most to least access. The owner created the list and manages
+// its members, editors change the list and its items, viewers can only read it. Commenters
+// are meant to comment on items on top of reading the list. Lists have no comments yet, so
+// for now a commenter has exactly the access of a viewer.
+const (
+	ListRoleOwner     = "owner"
+	ListRoleEditor    = "editor"
+	ListRoleCommenter = "commenter"
+	ListRoleViewer    = "viewer"
+)
+
+type ListMember struct {
+	UserID   uuid.UUID `json:"userId" db:"user_id"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+	Role     string    `json:"role"`
+	AddedAt  time.Time `json:"addedAt" db:"created_at"`
+}
+
+// AddListMemberDTO names the user to share the list with by username or email address.
+// Role defaults to editor.
+type AddListMemberDTO struct {
+	Login string `json:"login"`
+	Role  string `json:"role,omitempty"`
+}
+
+type UpdateListMemberDTO struct {
+	Role string `json:"role"`
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListTransfer is a pending handover of a list to another user, who has to accept it.
+type ListTransfer struct {
+	ID           uuid.UUID `json:"id"`
+	ListID       uuid.UUID `json:"listId" db:"list_id"`
+	ListTitle    string    `json:"listTitle" db:"list_title"`
+	FromUserID   uuid.UUID `json:"fromUserId" db:"from_user_id"`
+	FromUsername string    `json:"fromUsername" db:"from_username"`
+	ToUserID     uuid.UUID `json:"toUserId" db:"to_user_id"`
+	CreatedAt    time.Time `json:"createdAt" db:"created_at"`
+	ExpiresAt    time.Time `json:"expiresAt" db:"expires_at"`
+}
+
+// CreateListTransferDTO names the new owner by username or email address.
+type CreateListTransferDTO struct {
+	Login string `json:"login"`
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+)
+
+// LoginAttempts counts the recent failed sign-ins for an account or an IP address.
+type LoginAttempts struct {
+	Key           string     `json:"key"`
+	Failures      int        `json:"failures"`
+	LastFailureAt time.Time  `json:"lastFailureAt" db:"last_failure_at"`
+	LockedUntil   *time.Time `json:"lockedUntil" db:"locked_until"`
+}
+
+// LockedOutError is returned while sign-ins are locked after too many failures.
+type LockedOutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedOutError) Error() string {
+	return "too many failed sign-in attempts, try again later"
+}
+
+type UnlockSignInDTO struct {
+	Email string `json:"email"`
+	IP    string `json:"ip"`
+}
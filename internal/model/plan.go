@@ -0,0 +1,39 @@
+package model
+
+// Plans
+const (
+	PlanFree = "free"
+	PlanPro  = "pro"
+	PlanTeam = "team"
+)
+
+var Plans = []string{PlanFree, PlanPro, PlanTeam}
+
+// Plan holds the quotas of the users on it. Members of a list are counted without its owner,
+// items and members count towards the plan of the list's owner.
+type Plan struct {
+	Name              string `json:"name"`
+	MaxLists          int    `json:"maxLists" db:"max_lists"`
+	MaxItemsPerList   int    `json:"maxItemsPerList" db:"max_items_per_list"`
+	MaxMembersPerList int    `json:"maxMembersPerList" db:"max_members_per_list"`
+	MaxAttachments    int    `json:"maxAttachments" db:"max_attachments"`
+}
+
+type SetPlanDTO struct {
+	Plan string `json:"plan"`
+}
+
+type QuotaUsage struct {
+	Used  int `json:"used"`
+	Limit int `json:"limit"`
+}
+
+// Usage shows how much of their plan a user has used. Lists counts the lists the user owns,
+// ItemsPerList and MembersPerList the fullest of them.
+type Usage struct {
+	Plan           string     `json:"plan"`
+	Lists          QuotaUsage `json:"lists"`
+	ItemsPerList   QuotaUsage `json:"itemsPerList"`
+	MembersPerList QuotaUsage `json:"membersPerList"`
+	Attachments    QuotaUsage `json:"attachments"`
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Session struct {
+	ID               uuid.UUID  `json:"id"`
+	UserID           uuid.UUID  `json:"-" db:"user_id"`
+	RefreshTokenHash string     `json:"-" db:"refresh_token_hash"`
+	CreatedAt        time.Time  `json:"createdAt" db:"created_at"`
+	ExpiresAt        time.Time  `json:"expiresAt" db:"expires_at"`
+	RevokedAt        *time.Time `json:"-" db:"revoked_at"`
+	UserAgent        string     `json:"userAgent" db:"user_agent"`
+	IP               string     `json:"ip"`
+	LastSeenAt       time.Time  `json:"lastSeenAt" db:"last_seen_at"`
+	Current          bool       `json:"current" db:"-"`
+}
+
+type SessionMetadata struct {
+	UserAgent string
+	IP        string
+}
+
+// Tokens is the result of a sign-in. When the user has two-factor authentication
+// enabled only ChallengeToken is set, and the sign-in has to be completed with a code.
+type Tokens struct {
+	AccessToken    string
+	RefreshToken   string
+	ChallengeToken string
+
+	// UserID is the user who signed in, it is not sent to the client
+	UserID uuid.UUID
+}
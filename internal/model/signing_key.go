@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// SigningKey signs tokens until it is retired and stays available for verification
+// until it expires.
+type SigningKey struct {
+	ID         string     `json:"id"`
+	Algorithm  string     `json:"algorithm"`
+	PrivateKey string     `json:"-" db:"private_key"`
+	CreatedAt  time.Time  `json:"createdAt" db:"created_at"`
+	RetiredAt  *time.Time `json:"retiredAt" db:"retired_at"`
+	ExpiresAt  *time.Time `json:"expiresAt" db:"expires_at"`
+}
@@ -21,4 +21,10 @@ type TodoList struct {
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
 	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
+
+	// ArchivedAt is set while the list is archived, archived lists are read-only
+	ArchivedAt *time.Time `json:"archivedAt" db:"archived_at"`
+
+	// Role is the current user's role on the list
+	Role string `json:"role"`
 }
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// Claims is implemented by every token payload through the embedded RegisteredClaims.
+type Claims interface {
+	Valid() error
+	Registered() *RegisteredClaims
+}
+
+// RegisteredClaims are the RFC 7519 claims every token carries. Times are seconds since the epoch.
+type RegisteredClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	Audience  string `json:"aud"`
+	ExpiresAt int64  `json:"exp"`
+	NotBefore int64  `json:"nbf"`
+	IssuedAt  int64  `json:"iat"`
+	ID        string `json:"jti"`
+}
+
+// AccessTokenClaims identify the user by subject, the session the token belongs to and
+// the role the user had when the token was issued.
+type AccessTokenClaims struct {
+	RegisteredClaims
+	SessionID string `json:"sid"`
+	Role      string `json:"role"`
+}
+
+func (c *RegisteredClaims) Registered() *RegisteredClaims {
+	return c
+}
+
+// Valid satisfies jwt.Claims. The claims are checked by Validate instead, which also knows
+// the expected issuer and audience and allows for clock skew.
+func (c *RegisteredClaims) Valid() error {
+	return nil
+}
+
+// Validate returns the first reason the claims are not acceptable at the given time.
+func (c *RegisteredClaims) Validate(issuer, audience string, now time.Time, skew time.Duration) error {
+	if c.ID == "" {
+		return errors.New("token has no id")
+	}
+
+	if c.Issuer != issuer {
+		return errors.New("token has an unexpected issuer")
+	}
+
+	if c.Audience != audience {
+		return errors.New("token has an unexpected audience")
+	}
+
+	if c.ExpiresAt == 0 {
+		return errors.New("token has no expiry")
+	}
+
+	if now.Add(-skew).Unix() > c.ExpiresAt {
+		return errors.New("token is expired")
+	}
+
+	if now.Add(skew).Unix() < c.NotBefore {
+		return errors.New("token is not valid yet")
+	}
+
+	if now.Add(skew).Unix() < c.IssuedAt {
+		return errors.New("token was issued in the future")
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of trash entries
+const (
+	TrashTypeList = "list"
+	TrashTypeItem = "item"
+)
+
+// TrashEntry is a deleted list or item that can still be restored. Items of a deleted list
+// are not listed on their own, they come back with the list.
+type TrashEntry struct {
+	ID        uuid.UUID  `json:"id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	ListID    *uuid.UUID `json:"listId,omitempty" db:"list_id"`
+	DeletedAt time.Time  `json:"deletedAt" db:"deleted_at"`
+	PurgeAt   time.Time  `json:"purgeAt" db:"-"`
+
+	// ListArchivedAt is set when the list of the entry is archived
+	ListArchivedAt *time.Time `json:"-" db:"list_archived_at"`
+}
@@ -0,0 +1,15 @@
+package model
+
+type TwoFactorEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type TwoFactorCodeDTO struct {
+	Code string `json:"code"`
+}
+
+type TwoFactorSignInDTO struct {
+	ChallengeToken string `json:"challengeToken"`
+	Code           string `json:"code"`
+}
@@ -1,16 +1,56 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RoleUser    = "user"
+	RoleSupport = "support"
+	RoleAdmin   = "admin"
+)
+
+var Roles = []string{RoleUser, RoleSupport, RoleAdmin}
 
 type User struct {
-	ID           uuid.UUID `json:"id"`
-	Email        string    `json:"email"`
-	Username     string    `json:"username"`
-	PasswordHash string    `json:"-" db:"password_hash"`
+	ID           uuid.UUID  `json:"id"`
+	Email        string     `json:"email"`
+	Username     string     `json:"username"`
+	PasswordHash string     `json:"-" db:"password_hash"`
+	VerifiedAt   *time.Time `json:"verifiedAt" db:"verified_at"`
+	Role         string     `json:"role"`
+	Plan         string     `json:"plan"`
+	DisabledAt   *time.Time `json:"disabledAt" db:"disabled_at"`
+	CreatedAt    time.Time  `json:"createdAt" db:"created_at"`
+
+	TOTPSecret    *string    `json:"-" db:"totp_secret"`
+	TOTPEnabledAt *time.Time `json:"-" db:"totp_enabled_at"`
+	TOTPLastStep  int64      `json:"-" db:"totp_last_step"`
 }
 
 type CreateUserDTO struct {
 	Email    string `json:"email"`
 	Username string `json:"username"`
 	Password string `json:"password"`
+
+	// InvitationCode is required while registration is invite-only
+	InvitationCode string `json:"invitationCode,omitempty"`
+}
+
+// UpdateUserDTO changes the fields that are set. A new email address only replaces the
+// current one once it has been verified.
+type UpdateUserDTO struct {
+	Email    *string `json:"email"`
+	Username *string `json:"username"`
+}
+
+type ChangePasswordDTO struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+type DeleteUserDTO struct {
+	Password string `json:"password"`
 }
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity links a user to the subject an external OpenID Connect provider knows them by.
+type UserIdentity struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"userID" db:"user_id"`
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+}
+
+type OIDCLogin struct {
+	URL        string
+	StateToken string
+}
+
+type OIDCCallbackDTO struct {
+	Code       string
+	State      string
+	StateToken string
+	Error      string
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const (
+	personalAccessTokensTable = "personal_access_tokens"
+
+	// last_used_at is only written once per interval to keep scripted
+	// traffic from turning every request into a write
+	lastUsedUpdateInterval = time.Minute
+)
+
+type AccessTokenRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewAccessTokenRepositoryPostgres(db *sqlx.DB) AccessTokenRepository {
+	return &AccessTokenRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *AccessTokenRepositoryPostgres) Create(token model.PersonalAccessToken) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, name, token_hash, scopes, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, personalAccessTokensTable)
+
+	_, err := r.db.Exec(query, token.ID, token.UserID, token.Name, token.TokenHash, token.Scopes,
+		token.CreatedAt, token.ExpiresAt)
+
+	return err
+}
+
+func (r *AccessTokenRepositoryPostgres) GetAll(userID uuid.UUID) ([]model.PersonalAccessToken, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, name, token_hash, scopes, created_at, expires_at, last_used_at, revoked_at
+		FROM %s
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`, personalAccessTokensTable)
+
+	var tokens []model.PersonalAccessToken
+
+	return tokens, r.db.Select(&tokens, query, userID)
+}
+
+func (r *AccessTokenRepositoryPostgres) GetByTokenHash(hash string) (*model.PersonalAccessToken, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, name, token_hash, scopes, created_at, expires_at, last_used_at, revoked_at
+		FROM %s
+		WHERE token_hash = $1
+	`, personalAccessTokensTable)
+
+	var token model.PersonalAccessToken
+
+	return &token, r.db.Get(&token, query, hash)
+}
+
+func (r *AccessTokenRepositoryPostgres) Touch(tokenID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET last_used_at = $1
+		WHERE id = $2 AND (last_used_at IS NULL OR last_used_at < $3)
+	`, personalAccessTokensTable)
+
+	now := time.Now().UTC()
+	_, err := r.db.Exec(query, now, tokenID, now.Add(-lastUsedUpdateInterval))
+
+	return err
+}
+
+func (r *AccessTokenRepositoryPostgres) Revoke(userID, tokenID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE id = $2 AND user_id = $3 AND revoked_at IS NULL
+	`, personalAccessTokensTable)
+
+	res, err := r.db.Exec(query, time.Now().UTC(), tokenID, userID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
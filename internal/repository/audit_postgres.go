@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const auditEventsTable = "audit_events"
+
+type AuditRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewAuditRepositoryPostgres(db *sqlx.DB) AuditRepository {
+	return &AuditRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *AuditRepositoryPostgres) Create(event model.AuditEvent) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, actor_id, user_id, action, target_type, target_id, ip, user_agent, outcome, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, auditEventsTable)
+
+	_, err := r.db.Exec(query, event.ID, event.ActorID, event.UserID, event.Action, event.TargetType, event.TargetID,
+		event.IP, event.UserAgent, event.Outcome, event.Reason, event.CreatedAt)
+
+	return err
+}
+
+// Find returns the events matching every set field of the filter, newest first.
+func (r *AuditRepositoryPostgres) Find(filter model.AuditFilter, pagination model.Pagination) ([]model.AuditEvent, error) {
+	conditions := make([]string, 0)
+
+	args := make([]interface{}, 0)
+	argsID := 1
+
+	if filter.ActorID != nil {
+		conditions = append(conditions, fmt.Sprintf("actor_id = $%d", argsID))
+		args = append(args, *filter.ActorID)
+		argsID++
+	}
+
+	if filter.UserID != nil {
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", argsID))
+		args = append(args, *filter.UserID)
+		argsID++
+	}
+
+	if filter.Action != "" {
+		conditions = append(conditions, fmt.Sprintf("action = $%d", argsID))
+		args = append(args, filter.Action)
+		argsID++
+	}
+
+	if filter.Outcome != "" {
+		conditions = append(conditions, fmt.Sprintf("outcome = $%d", argsID))
+		args = append(args, filter.Outcome)
+		argsID++
+	}
+
+	if filter.From != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", argsID))
+		args = append(args, *filter.From)
+		argsID++
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", argsID))
+		args = append(args, *filter.To)
+		argsID++
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, actor_id, user_id, action, target_type, target_id, ip, user_agent, outcome, reason, created_at
+		FROM %s
+		%s
+		ORDER BY created_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, auditEventsTable, where, argsID, argsID+1)
+
+	args = append(args, pagination.Limit, pagination.Limit*(pagination.Page-1))
+
+	var events []model.AuditEvent
+
+	return events, r.db.Select(&events, query, args...)
+}
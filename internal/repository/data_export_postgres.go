@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const dataExportsTable = "data_exports"
+
+type DataExportRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewDataExportRepositoryPostgres(db *sqlx.DB) DataExportRepository {
+	return &DataExportRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *DataExportRepositoryPostgres) Create(export model.DataExport) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, status, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, dataExportsTable)
+
+	_, err := r.db.Exec(query, export.ID, export.UserID, export.Status, export.CreatedAt)
+
+	return err
+}
+
+// GetByID returns the export without the archive.
+func (r *DataExportRepositoryPostgres) GetByID(userID, exportID uuid.UUID) (*model.DataExport, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, status, created_at, completed_at, expires_at
+		FROM %s
+		WHERE id = $1 AND user_id = $2
+	`, dataExportsTable)
+
+	var export model.DataExport
+
+	return &export, r.db.Get(&export, query, exportID, userID)
+}
+
+func (r *DataExportRepositoryPostgres) GetArchive(exportID uuid.UUID) (*model.DataExport, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, status, archive, created_at, completed_at, expires_at
+		FROM %s
+		WHERE id = $1
+	`, dataExportsTable)
+
+	var export model.DataExport
+
+	return &export, r.db.Get(&export, query, exportID)
+}
+
+func (r *DataExportRepositoryPostgres) Complete(exportID uuid.UUID, archive []byte, completedAt, expiresAt time.Time) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $1, archive = $2, completed_at = $3, expires_at = $4
+		WHERE id = $5 AND status = $6
+	`, dataExportsTable)
+
+	res, err := r.db.Exec(query, model.DataExportReady, archive, completedAt, expiresAt, exportID, model.DataExportPending)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+func (r *DataExportRepositoryPostgres) Fail(exportID uuid.UUID, completedAt time.Time) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $1, completed_at = $2
+		WHERE id = $3 AND status = $4
+	`, dataExportsTable)
+
+	_, err := r.db.Exec(query, model.DataExportFailed, completedAt, exportID, model.DataExportPending)
+
+	return err
+}
+
+// Cleanup fails the pending exports of the user created before staleBefore, which were
+// interrupted e.g. by a restart, and deletes every expired archive.
+func (r *DataExportRepositoryPostgres) Cleanup(userID uuid.UUID, staleBefore, now time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	failStaleQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $1, completed_at = $2
+		WHERE user_id = $3 AND status = $4 AND created_at < $5
+	`, dataExportsTable)
+
+	if _, err := tx.Exec(failStaleQuery, model.DataExportFailed, now, userID, model.DataExportPending, staleBefore); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deleteExpiredQuery := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE expires_at < $1
+	`, dataExportsTable)
+
+	if _, err := tx.Exec(deleteExpiredQuery, now); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
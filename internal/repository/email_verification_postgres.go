@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const emailVerificationTokensTable = "email_verification_tokens"
+
+type EmailVerificationRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewEmailVerificationRepositoryPostgres(db *sqlx.DB) EmailVerificationRepository {
+	return &EmailVerificationRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *EmailVerificationRepositoryPostgres) Create(token model.EmailVerificationToken) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, email, token_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, emailVerificationTokensTable)
+
+	_, err := r.db.Exec(query, token.ID, token.UserID, token.Email, token.TokenHash, token.CreatedAt, token.ExpiresAt)
+
+	return err
+}
+
+func (r *EmailVerificationRepositoryPostgres) GetByTokenHash(hash string) (*model.EmailVerificationToken, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, email, token_hash, created_at, expires_at, used_at
+		FROM %s
+		WHERE token_hash = $1
+	`, emailVerificationTokensTable)
+
+	var token model.EmailVerificationToken
+
+	return &token, r.db.Get(&token, query, hash)
+}
+
+// Use consumes the token and marks the address it was issued for as the verified
+// email of the user.
+func (r *EmailVerificationRepositoryPostgres) Use(tokenID, userID uuid.UUID, email string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	useTokenQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET used_at = $1
+		WHERE id = $2 AND used_at IS NULL
+	`, emailVerificationTokensTable)
+
+	res, err := tx.Exec(useTokenQuery, now, tokenID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	verifyQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET email = $1, verified_at = $2
+		WHERE id = $3
+	`, usersTable)
+
+	if _, err := tx.Exec(verifyQuery, email, now, userID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
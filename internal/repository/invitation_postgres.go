@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const invitationsTable = "invitations"
+
+type InvitationRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewInvitationRepositoryPostgres(db *sqlx.DB) InvitationRepository {
+	return &InvitationRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *InvitationRepositoryPostgres) Create(invitation model.Invitation) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, code_hash, created_by, max_uses, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, invitationsTable)
+
+	_, err := r.db.Exec(query, invitation.ID, invitation.CodeHash, invitation.CreatedBy, invitation.MaxUses,
+		invitation.CreatedAt, invitation.ExpiresAt)
+
+	return err
+}
+
+// GetAll returns the invitations that were not revoked, newest first. Expired and used up
+// invitations are included so admins can see how they were used.
+func (r *InvitationRepositoryPostgres) GetAll() ([]model.Invitation, error) {
+	query := fmt.Sprintf(`
+		SELECT id, code_hash, created_by, max_uses, uses, created_at, expires_at, revoked_at
+		FROM %s
+		WHERE revoked_at IS NULL
+		ORDER BY created_at DESC
+	`, invitationsTable)
+
+	var invitations []model.Invitation
+
+	return invitations, r.db.Select(&invitations, query)
+}
+
+func (r *InvitationRepositoryPostgres) Revoke(invitationID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE id = $2 AND revoked_at IS NULL
+	`, invitationsTable)
+
+	res, err := r.db.Exec(query, time.Now().UTC(), invitationID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const listInvitesTable = "list_invites"
+
+type ListInviteRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewListInviteRepositoryPostgres(db *sqlx.DB) ListInviteRepository {
+	return &ListInviteRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *ListInviteRepositoryPostgres) Create(invite model.ListInvite) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, list_id, token_hash, role, created_by, max_uses, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, listInvitesTable)
+
+	_, err := r.db.Exec(query, invite.ID, invite.ListID, invite.TokenHash, invite.Role, invite.CreatedBy,
+		invite.MaxUses, invite.CreatedAt, invite.ExpiresAt)
+
+	return err
+}
+
+// GetAll returns the outstanding invites of the list, newest first: not revoked, not expired
+// and not used up.
+func (r *ListInviteRepositoryPostgres) GetAll(listID uuid.UUID, now time.Time) ([]model.ListInvite, error) {
+	query := fmt.Sprintf(`
+		SELECT id, list_id, token_hash, role, created_by, max_uses, uses, created_at, expires_at, revoked_at
+		FROM %s
+		WHERE list_id = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > $2)
+			AND uses < max_uses
+		ORDER BY created_at DESC
+	`, listInvitesTable)
+
+	var invites []model.ListInvite
+
+	return invites, r.db.Select(&invites, query, listID, now)
+}
+
+func (r *ListInviteRepositoryPostgres) Revoke(listID, inviteID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE id = $2 AND list_id = $3 AND revoked_at IS NULL
+	`, listInvitesTable)
+
+	res, err := r.db.Exec(query, time.Now().UTC(), inviteID, listID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// Accept uses up one use of a valid invite and adds the user to its list with the invite's role
+// in the same transaction, so a user who already is a member does not cost the invite a use. It
+// returns the list, sql.ErrNoRows if the token is unknown, revoked, expired or used up, or
+// ErrQuotaExceeded if the list has as many members as the plan of its owner allows.
+func (r *ListInviteRepositoryPostgres) Accept(tokenHash string, userID uuid.UUID, now time.Time) (uuid.UUID, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	useInviteQuery := fmt.Sprintf(`
+		UPDATE %s inv
+		SET uses = inv.uses + 1
+		FROM %s tl
+		WHERE inv.token_hash = $1 AND inv.revoked_at IS NULL AND (inv.expires_at IS NULL OR inv.expires_at > $2)
+			AND inv.uses < inv.max_uses AND tl.id = inv.list_id AND tl.deleted_at IS NULL
+		RETURNING inv.list_id, inv.role
+	`, listInvitesTable, todoListsTable)
+
+	var (
+		listID uuid.UUID
+		role   string
+	)
+
+	if err := tx.QueryRow(useInviteQuery, tokenHash, now).Scan(&listID, &role); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	if err := checkMemberQuota(tx, listID); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	addMemberQuery := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, list_id, role, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, usersListsTable)
+
+	if _, err := tx.Exec(addMemberQuery, uuid.New(), userID, listID, role, now); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	return listID, tx.Commit()
+}
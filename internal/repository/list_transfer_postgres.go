@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const listTransfersTable = "list_transfers"
+
+type ListTransferRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewListTransferRepositoryPostgres(db *sqlx.DB) ListTransferRepository {
+	return &ListTransferRepositoryPostgres{
+		db: db,
+	}
+}
+
+// Create stores the transfer, replacing a pending transfer of the same list.
+func (r *ListTransferRepositoryPostgres) Create(transfer model.ListTransfer) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, list_id, from_user_id, to_user_id, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (list_id) DO UPDATE
+		SET id = EXCLUDED.id, from_user_id = EXCLUDED.from_user_id, to_user_id = EXCLUDED.to_user_id,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+	`, listTransfersTable)
+
+	_, err := r.db.Exec(query, transfer.ID, transfer.ListID, transfer.FromUserID, transfer.ToUserID,
+		transfer.CreatedAt, transfer.ExpiresAt)
+
+	return err
+}
+
+// GetIncoming returns the transfers waiting for the user to accept them, newest first.
+func (r *ListTransferRepositoryPostgres) GetIncoming(userID uuid.UUID, now time.Time) ([]model.ListTransfer, error) {
+	query := fmt.Sprintf(`
+		SELECT lt.id, lt.list_id, tl.title AS list_title, lt.from_user_id, u.username AS from_username,
+			lt.to_user_id, lt.created_at, lt.expires_at
+		FROM %s lt
+		INNER JOIN %s tl ON tl.id = lt.list_id
+		INNER JOIN %s u ON u.id = lt.from_user_id
+		WHERE lt.to_user_id = $1 AND lt.expires_at > $2 AND tl.deleted_at IS NULL
+		ORDER BY lt.created_at DESC
+	`, listTransfersTable, todoListsTable, usersTable)
+
+	var transfers []model.ListTransfer
+
+	return transfers, r.db.Select(&transfers, query, userID, now)
+}
+
+// Cancel removes the pending transfer of the list.
+func (r *ListTransferRepositoryPostgres) Cancel(listID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE list_id = $1
+	`, listTransfersTable)
+
+	res, err := r.db.Exec(query, listID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// Decline removes a transfer addressed to the user.
+func (r *ListTransferRepositoryPostgres) Decline(transferID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE id = $1 AND to_user_id = $2
+	`, listTransfersTable)
+
+	res, err := r.db.Exec(query, transferID, userID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// Accept makes the user the owner of the list in one transaction. The previous owner stays on
+// the list as an editor and counts as a member from then on. It returns the list, ErrQuotaExceeded
+// if the list is active and the user owns as many active lists as their plan allows,
+// ErrMemberQuotaExceeded if the list would be shared with more users than their plan allows, or
+// sql.ErrNoRows if the transfer is unknown, expired, not addressed to the user or the sender no
+// longer owns the list.
+func (r *ListTransferRepositoryPostgres) Accept(transferID, userID uuid.UUID, now time.Time) (uuid.UUID, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	deleteTransferQuery := fmt.Sprintf(`
+		DELETE FROM %s lt
+		USING %s tl
+		WHERE lt.id = $1 AND lt.to_user_id = $2 AND lt.expires_at > $3 AND tl.id = lt.list_id
+			AND tl.deleted_at IS NULL
+		RETURNING lt.list_id, lt.from_user_id, tl.archived_at
+	`, listTransfersTable, todoListsTable)
+
+	var listID, fromUserID uuid.UUID
+	var archivedAt *time.Time
+	if err := tx.QueryRow(deleteTransferQuery, transferID, userID, now).Scan(&listID, &fromUserID, &archivedAt); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	// Archived lists do not count towards the quota
+	if archivedAt == nil {
+		if err := checkListQuota(tx, userID); err != nil {
+			tx.Rollback()
+			return uuid.Nil, err
+		}
+	}
+
+	demoteOwnerQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET role = $1
+		WHERE list_id = $2 AND user_id = $3 AND role = $4
+	`, usersListsTable)
+
+	res, err := tx.Exec(demoteOwnerQuery, model.ListRoleEditor, listID, fromUserID, model.ListRoleOwner)
+	if err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	// The recipient may already be a member of the list
+	setOwnerQuery := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, list_id, role, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, list_id) DO UPDATE
+		SET role = EXCLUDED.role
+	`, usersListsTable)
+
+	if _, err := tx.Exec(setOwnerQuery, uuid.New(), userID, listID, model.ListRoleOwner, now); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	// Members are now counted against the plan of the recipient, with the previous owner among them
+	if err := checkMembersWithinQuota(tx, listID); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	return listID, tx.Commit()
+}
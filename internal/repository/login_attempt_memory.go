@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// How often stale counters are dropped from memory
+const loginAttemptsSweepInterval = time.Minute
+
+// LoginAttemptRepositoryMemory keeps the counters in the process. It suits a single instance;
+// counters are lost on restart and are not shared between instances.
+type LoginAttemptRepositoryMemory struct {
+	mu        sync.Mutex
+	attempts  map[string]*model.LoginAttempts
+	window    time.Duration
+	lastSwept time.Time
+}
+
+func NewLoginAttemptRepositoryMemory() LoginAttemptRepository {
+	return &LoginAttemptRepositoryMemory{
+		attempts: make(map[string]*model.LoginAttempts),
+	}
+}
+
+func (r *LoginAttemptRepositoryMemory) Get(key string) (*model.LoginAttempts, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempts, ok := r.attempts[key]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	result := *attempts
+	return &result, nil
+}
+
+func (r *LoginAttemptRepositoryMemory) RegisterFailure(key string, now time.Time, window time.Duration) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.window = window
+	r.sweep(now)
+
+	attempts, ok := r.attempts[key]
+	if !ok || lastActivity(attempts).Before(now.Add(-window)) {
+		attempts = &model.LoginAttempts{Key: key}
+		r.attempts[key] = attempts
+	}
+
+	attempts.Failures++
+	attempts.LastFailureAt = now
+
+	return attempts.Failures, nil
+}
+
+func (r *LoginAttemptRepositoryMemory) Lock(key string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempts, ok := r.attempts[key]
+	if !ok {
+		return nil
+	}
+
+	if attempts.LockedUntil == nil || attempts.LockedUntil.Before(until) {
+		attempts.LockedUntil = &until
+	}
+
+	return nil
+}
+
+func (r *LoginAttemptRepositoryMemory) Delete(key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.attempts[key]; !ok {
+		return sql.ErrNoRows
+	}
+
+	delete(r.attempts, key)
+
+	return nil
+}
+
+// sweep must be called with mu held.
+func (r *LoginAttemptRepositoryMemory) sweep(now time.Time) {
+	if now.Sub(r.lastSwept) < loginAttemptsSweepInterval {
+		return
+	}
+
+	for key, attempts := range r.attempts {
+		if lastActivity(attempts).Before(now.Add(-r.window)) {
+			delete(r.attempts, key)
+		}
+	}
+
+	r.lastSwept = now
+}
+
+func lastActivity(attempts *model.LoginAttempts) time.Time {
+	if attempts.LockedUntil != nil && attempts.LockedUntil.After(attempts.LastFailureAt) {
+		return *attempts.LockedUntil
+	}
+
+	return attempts.LastFailureAt
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const loginAttemptsTable = "login_attempts"
+
+type LoginAttemptRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewLoginAttemptRepositoryPostgres(db *sqlx.DB) LoginAttemptRepository {
+	return &LoginAttemptRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *LoginAttemptRepositoryPostgres) Get(key string) (*model.LoginAttempts, error) {
+	query := fmt.Sprintf(`
+		SELECT key, failures, last_failure_at, locked_until
+		FROM %s
+		WHERE key = $1
+	`, loginAttemptsTable)
+
+	var attempts model.LoginAttempts
+
+	return &attempts, r.db.Get(&attempts, query, key)
+}
+
+// RegisterFailure counts a failed attempt and returns the number of failures in a row. The count
+// starts over once the key has been quiet, and not locked, for longer than the window.
+func (r *LoginAttemptRepositoryPostgres) RegisterFailure(key string, now time.Time, window time.Duration) (int, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO %[1]s (key, failures, last_failure_at)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET failures = CASE
+				WHEN GREATEST(%[1]s.last_failure_at, COALESCE(%[1]s.locked_until, %[1]s.last_failure_at)) < $3 THEN 1
+				ELSE %[1]s.failures + 1
+			END,
+			last_failure_at = $2
+		RETURNING failures
+	`, loginAttemptsTable)
+
+	var failures int
+
+	return failures, r.db.QueryRow(query, key, now, now.Add(-window)).Scan(&failures)
+}
+
+func (r *LoginAttemptRepositoryPostgres) Lock(key string, until time.Time) error {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s
+		SET locked_until = GREATEST(COALESCE(%[1]s.locked_until, $1), $1)
+		WHERE key = $2
+	`, loginAttemptsTable)
+
+	_, err := r.db.Exec(query, until, key)
+
+	return err
+}
+
+func (r *LoginAttemptRepositoryPostgres) Delete(key string) error {
+	query := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE key = $1
+	`, loginAttemptsTable)
+
+	res, err := r.db.Exec(query, key)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
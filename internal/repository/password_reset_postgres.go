@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const passwordResetTokensTable = "password_reset_tokens"
+
+type PasswordResetRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewPasswordResetRepositoryPostgres(db *sqlx.DB) PasswordResetRepository {
+	return &PasswordResetRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *PasswordResetRepositoryPostgres) Create(token model.PasswordResetToken) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, token_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, passwordResetTokensTable)
+
+	_, err := r.db.Exec(query, token.ID, token.UserID, token.TokenHash, token.CreatedAt, token.ExpiresAt)
+
+	return err
+}
+
+func (r *PasswordResetRepositoryPostgres) GetByTokenHash(hash string) (*model.PasswordResetToken, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, token_hash, created_at, expires_at, used_at
+		FROM %s
+		WHERE token_hash = $1
+	`, passwordResetTokensTable)
+
+	var token model.PasswordResetToken
+
+	return &token, r.db.Get(&token, query, hash)
+}
+
+// Use consumes the token, stores the new password hash and signs the user out
+// of every session in a single transaction.
+func (r *PasswordResetRepositoryPostgres) Use(tokenID, userID uuid.UUID, passwordHash string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	useTokenQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET used_at = $1
+		WHERE id = $2 AND used_at IS NULL
+	`, passwordResetTokensTable)
+
+	res, err := tx.Exec(useTokenQuery, now, tokenID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	updatePasswordQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET password_hash = $1
+		WHERE id = $2
+	`, usersTable)
+
+	if _, err := tx.Exec(updatePasswordQuery, passwordHash, userID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	revokeSessionsQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE user_id = $2 AND revoked_at IS NULL
+	`, sessionsTable)
+
+	if _, err := tx.Exec(revokeSessionsQuery, now, userID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
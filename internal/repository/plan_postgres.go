@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const plansTable = "plans"
+
+type PlanRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewPlanRepositoryPostgres(db *sqlx.DB) PlanRepository {
+	return &PlanRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *PlanRepositoryPostgres) Save(plan model.Plan) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (name, max_lists, max_items_per_list, max_members_per_list, max_attachments)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (name) DO UPDATE
+		SET max_lists = EXCLUDED.max_lists, max_items_per_list = EXCLUDED.max_items_per_list,
+			max_members_per_list = EXCLUDED.max_members_per_list, max_attachments = EXCLUDED.max_attachments
+	`, plansTable)
+
+	_, err := r.db.Exec(query, plan.Name, plan.MaxLists, plan.MaxItemsPerList, plan.MaxMembersPerList,
+		plan.MaxAttachments)
+
+	return err
+}
+
+// GetUsage returns the plan of the user with its quotas and how much of them is used.
+// Archived lists do not count towards the list quota, and nothing in the trash counts at all.
+// Attachments are not stored yet, so none of them are used.
+func (r *PlanRepositoryPostgres) GetUsage(userID uuid.UUID) (model.Usage, error) {
+	query := fmt.Sprintf(`
+		SELECT p.name, p.max_lists, p.max_items_per_list, p.max_members_per_list, p.max_attachments,
+			(SELECT COUNT(*)
+				FROM %[3]s ul
+				INNER JOIN %[5]s tl ON tl.id = ul.list_id
+				WHERE ul.user_id = u.id AND ul.role = $2 AND tl.archived_at IS NULL AND tl.deleted_at IS NULL
+			) AS lists,
+			(SELECT COALESCE(MAX(items), 0) FROM (
+				SELECT COUNT(*) AS items
+				FROM %[4]s li
+				INNER JOIN %[6]s ti ON ti.id = li.item_id
+				INNER JOIN %[5]s tl ON tl.id = li.list_id
+				INNER JOIN %[3]s ul ON ul.list_id = li.list_id
+				WHERE ul.user_id = u.id AND ul.role = $2 AND ti.deleted_at IS NULL AND tl.deleted_at IS NULL
+				GROUP BY li.list_id
+			) AS list_items) AS items,
+			(SELECT COALESCE(MAX(members), 0) FROM (
+				SELECT COUNT(*) AS members
+				FROM %[3]s m
+				INNER JOIN %[5]s tl ON tl.id = m.list_id
+				INNER JOIN %[3]s ul ON ul.list_id = m.list_id
+				WHERE ul.user_id = u.id AND ul.role = $2 AND m.role != $2 AND tl.deleted_at IS NULL
+				GROUP BY m.list_id
+			) AS list_members) AS members
+		FROM %[1]s u
+		INNER JOIN %[2]s p ON p.name = u.plan
+		WHERE u.id = $1
+	`, usersTable, plansTable, usersListsTable, listsItemsTable, todoListsTable, todoItemsTable)
+
+	var (
+		plan                  model.Plan
+		lists, items, members int
+	)
+
+	if err := r.db.QueryRow(query, userID, model.ListRoleOwner).Scan(&plan.Name, &plan.MaxLists,
+		&plan.MaxItemsPerList, &plan.MaxMembersPerList, &plan.MaxAttachments, &lists, &items, &members); err != nil {
+		return model.Usage{}, err
+	}
+
+	return model.Usage{
+		Plan:           plan.Name,
+		Lists:          model.QuotaUsage{Used: lists, Limit: plan.MaxLists},
+		ItemsPerList:   model.QuotaUsage{Used: items, Limit: plan.MaxItemsPerList},
+		MembersPerList: model.QuotaUsage{Used: members, Limit: plan.MaxMembersPerList},
+		Attachments:    model.QuotaUsage{Used: 0, Limit: plan.MaxAttachments},
+	}, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// ErrQuotaExceeded is returned when a statement would take a user over a quota of their plan.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// ErrMemberQuotaExceeded is ErrQuotaExceeded for the members of a list, it tells the quotas
+// apart where a statement checks more than one.
+var ErrMemberQuotaExceeded = fmt.Errorf("member %w", ErrQuotaExceeded)
+
+// The checks below lock the row the quota belongs to before counting, so concurrent
+// transactions checking the same quota wait for each other and cannot both squeeze in.
+// They have to run in the transaction that inserts the counted row.
+
+// checkListQuota fails when the user owns as many active lists as their plan allows,
+// archived lists and lists in the trash do not count.
+func checkListQuota(tx *sql.Tx, userID uuid.UUID) error {
+	limitQuery := fmt.Sprintf(`
+		SELECT p.max_lists
+		FROM %s u
+		INNER JOIN %s p ON p.name = u.plan
+		WHERE u.id = $1
+		FOR UPDATE OF u
+	`, usersTable, plansTable)
+
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM %s ul
+		INNER JOIN %s tl ON tl.id = ul.list_id
+		WHERE ul.user_id = $1 AND ul.role = $2 AND tl.archived_at IS NULL AND tl.deleted_at IS NULL
+	`, usersListsTable, todoListsTable)
+
+	return checkQuota(tx, limitQuery, countQuery, []interface{}{userID}, []interface{}{userID, model.ListRoleOwner})
+}
+
+// checkItemQuota fails when the list holds as many items as the plan of its owner allows,
+// items in the trash do not count.
+func checkItemQuota(tx *sql.Tx, listID uuid.UUID) error {
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM %s li
+		INNER JOIN %s ti ON ti.id = li.item_id
+		WHERE li.list_id = $1 AND ti.deleted_at IS NULL
+	`, listsItemsTable, todoItemsTable)
+
+	return checkQuota(tx, listLimitQuery("max_items_per_list"), countQuery,
+		[]interface{}{listID, model.ListRoleOwner}, []interface{}{listID})
+}
+
+// checkMemberQuota fails when the list is shared with as many users as the plan of its owner allows.
+func checkMemberQuota(tx *sql.Tx, listID uuid.UUID) error {
+	limit, count, err := memberQuotaUsage(tx, listID)
+	if err != nil {
+		return err
+	}
+
+	if count >= limit {
+		return ErrMemberQuotaExceeded
+	}
+
+	return nil
+}
+
+// checkMembersWithinQuota fails when the list is shared with more users than the plan of its
+// owner allows. It is for statements that change the members without inserting one.
+func checkMembersWithinQuota(tx *sql.Tx, listID uuid.UUID) error {
+	limit, count, err := memberQuotaUsage(tx, listID)
+	if err != nil {
+		return err
+	}
+
+	if count > limit {
+		return ErrMemberQuotaExceeded
+	}
+
+	return nil
+}
+
+func memberQuotaUsage(tx *sql.Tx, listID uuid.UUID) (int, int, error) {
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM %s
+		WHERE list_id = $1 AND role != $2
+	`, usersListsTable)
+
+	return quotaUsage(tx, listLimitQuery("max_members_per_list"), countQuery,
+		[]interface{}{listID, model.ListRoleOwner}, []interface{}{listID, model.ListRoleOwner})
+}
+
+// listLimitQuery locks the list and selects the quota column from the plan of its owner.
+func listLimitQuery(column string) string {
+	return fmt.Sprintf(`
+		SELECT p.%s
+		FROM %s tl
+		INNER JOIN %s ul ON ul.list_id = tl.id AND ul.role = $2
+		INNER JOIN %s u ON u.id = ul.user_id
+		INNER JOIN %s p ON p.name = u.plan
+		WHERE tl.id = $1
+		FOR UPDATE OF tl
+	`, column, todoListsTable, usersListsTable, usersTable, plansTable)
+}
+
+func checkQuota(tx *sql.Tx, limitQuery, countQuery string, limitArgs, countArgs []interface{}) error {
+	limit, count, err := quotaUsage(tx, limitQuery, countQuery, limitArgs, countArgs)
+	if err != nil {
+		return err
+	}
+
+	if count >= limit {
+		return ErrQuotaExceeded
+	}
+
+	return nil
+}
+
+func quotaUsage(tx *sql.Tx, limitQuery, countQuery string, limitArgs, countArgs []interface{}) (int, int, error) {
+	var limit int
+	if err := tx.QueryRow(limitQuery, limitArgs...).Scan(&limit); err != nil {
+		return 0, 0, err
+	}
+
+	var count int
+	if err := tx.QueryRow(countQuery, countArgs...).Scan(&count); err != nil {
+		return 0, 0, err
+	}
+
+	return limit, count, nil
+}
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"database/sql"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/rtsoy/todo-app/internal/model"
@@ -10,33 +13,210 @@ type TodoItemRepository interface {
 	Create(listID uuid.UUID, item model.CreateTodoItemDTO) (uuid.UUID, error)
 	GetAll(userID, listID uuid.UUID, pagination *model.Pagination, orderBy *string) ([]model.TodoItem, error)
 	GetByID(userID, itemID uuid.UUID) (model.TodoItem, error)
+	GetList(userID, itemID uuid.UUID) (model.TodoList, error)
 	Update(userID, itemID uuid.UUID, data model.UpdateTodoItemDTO) error
 	Delete(userID, itemID uuid.UUID) error
 }
 
 type TodoListRepository interface {
 	Create(userID uuid.UUID, list model.CreateTodoListDTO) (uuid.UUID, error)
-	GetAll(userID uuid.UUID, orderBy *string) ([]model.TodoList, error)
+	GetAll(userID uuid.UUID, archived *bool, orderBy *string) ([]model.TodoList, error)
 	GetByID(userID, listID uuid.UUID) (model.TodoList, error)
 	Update(userID, listID uuid.UUID, data model.UpdateTodoListDTO) error
 	Delete(userID, listID uuid.UUID) error
+	Archive(listID uuid.UUID, archivedAt time.Time) error
+	Unarchive(ownerID, listID uuid.UUID) error
+	GetRole(userID, listID uuid.UUID) (string, error)
+	GetMembers(listID uuid.UUID) ([]model.ListMember, error)
+	AddMember(listID, userID uuid.UUID, role string) error
+	UpdateMemberRole(listID, userID uuid.UUID, role string) error
+	RemoveMember(listID, userID uuid.UUID) error
 }
 
 type UserRepository interface {
 	Create(user model.CreateUserDTO) (uuid.UUID, error)
+	CreateWithInvitation(user model.CreateUserDTO, codeHash string, now time.Time) (uuid.UUID, error)
+	GetByID(userID uuid.UUID) (*model.User, error)
 	GetByEmail(email string) (*model.User, error)
+	GetByLogin(login string) (*model.User, error)
+	UpdateUsername(userID uuid.UUID, username string) error
+	UpdatePassword(userID, currentSessionID uuid.UUID, passwordHash string) error
+	UpdatePasswordHash(userID uuid.UUID, oldHash, newHash string) error
+	Delete(userID uuid.UUID) error
+	Search(search string, pagination model.Pagination) ([]model.User, error)
+	SetRole(userID uuid.UUID, role string) error
+	SetRoleByEmail(emails []string, role string) error
+	SetPlan(userID uuid.UUID, plan string) error
+	Disable(userID uuid.UUID, disabledAt time.Time) error
+	Enable(userID uuid.UUID) error
+}
+
+type SessionRepository interface {
+	Create(session model.Session) error
+	GetByID(sessionID uuid.UUID) (*model.Session, error)
+	GetByRefreshTokenHash(hash string) (*model.Session, error)
+	GetAllActive(userID uuid.UUID) ([]model.Session, error)
+	Rotate(sessionID uuid.UUID, oldHash, newHash string, expiresAt time.Time, metadata model.SessionMetadata) error
+	Revoke(sessionID uuid.UUID) error
+	RevokeByID(userID, sessionID uuid.UUID) error
+	RevokeAll(userID uuid.UUID) error
+}
+
+type PasswordResetRepository interface {
+	Create(token model.PasswordResetToken) error
+	GetByTokenHash(hash string) (*model.PasswordResetToken, error)
+	Use(tokenID, userID uuid.UUID, passwordHash string) error
+}
+
+type EmailVerificationRepository interface {
+	Create(token model.EmailVerificationToken) error
+	GetByTokenHash(hash string) (*model.EmailVerificationToken, error)
+	Use(tokenID, userID uuid.UUID, email string) error
+}
+
+type TwoFactorRepository interface {
+	SetSecret(userID uuid.UUID, secret string) error
+	Enable(userID uuid.UUID, step int64, recoveryCodeHashes []string) error
+	Disable(userID uuid.UUID) error
+	UseStep(userID uuid.UUID, step int64) error
+	UseRecoveryCode(userID uuid.UUID, codeHash string) error
+}
+
+type AccessTokenRepository interface {
+	Create(token model.PersonalAccessToken) error
+	GetAll(userID uuid.UUID) ([]model.PersonalAccessToken, error)
+	GetByTokenHash(hash string) (*model.PersonalAccessToken, error)
+	Touch(tokenID uuid.UUID) error
+	Revoke(userID, tokenID uuid.UUID) error
+}
+
+type UserIdentityRepository interface {
+	Create(identity model.UserIdentity) error
+	GetBySubject(provider, subject string) (*model.UserIdentity, error)
+	CreateWithUser(user model.User, identity model.UserIdentity) error
+}
+
+type SigningKeyRepository interface {
+	GetAllValid() ([]model.SigningKey, error)
+	Rotate(key model.SigningKey, currentKeyID string, expiresAt time.Time) error
+}
+
+type LoginAttemptRepository interface {
+	Get(key string) (*model.LoginAttempts, error)
+	RegisterFailure(key string, now time.Time, window time.Duration) (int, error)
+	Lock(key string, until time.Time) error
+	Delete(key string) error
+}
+
+type DataExportRepository interface {
+	Create(export model.DataExport) error
+	GetByID(userID, exportID uuid.UUID) (*model.DataExport, error)
+	GetArchive(exportID uuid.UUID) (*model.DataExport, error)
+	Complete(exportID uuid.UUID, archive []byte, completedAt, expiresAt time.Time) error
+	Fail(exportID uuid.UUID, completedAt time.Time) error
+	Cleanup(userID uuid.UUID, staleBefore, now time.Time) error
+}
+
+type InvitationRepository interface {
+	Create(invitation model.Invitation) error
+	GetAll() ([]model.Invitation, error)
+	Revoke(invitationID uuid.UUID) error
+}
+
+type ListInviteRepository interface {
+	Create(invite model.ListInvite) error
+	GetAll(listID uuid.UUID, now time.Time) ([]model.ListInvite, error)
+	Revoke(listID, inviteID uuid.UUID) error
+	Accept(tokenHash string, userID uuid.UUID, now time.Time) (uuid.UUID, error)
+}
+
+type ListTransferRepository interface {
+	Create(transfer model.ListTransfer) error
+	GetIncoming(userID uuid.UUID, now time.Time) ([]model.ListTransfer, error)
+	Cancel(listID uuid.UUID) error
+	Decline(transferID, userID uuid.UUID) error
+	Accept(transferID, userID uuid.UUID, now time.Time) (uuid.UUID, error)
+}
+
+type TrashRepository interface {
+	GetAll(userID uuid.UUID) ([]model.TrashEntry, error)
+	GetByID(userID, entryID uuid.UUID) (model.TrashEntry, error)
+	RestoreList(ownerID, listID uuid.UUID) error
+	RestoreItem(listID, itemID uuid.UUID) error
+	Purge(deletedBefore time.Time) error
+}
+
+type PlanRepository interface {
+	Save(plan model.Plan) error
+	GetUsage(userID uuid.UUID) (model.Usage, error)
+}
+
+// AuditRepository only appends events, the table rejects updates and deletes.
+type AuditRepository interface {
+	Create(event model.AuditEvent) error
+	Find(filter model.AuditFilter, pagination model.Pagination) ([]model.AuditEvent, error)
+}
+
+type StatsRepository interface {
+	Get(now time.Time) (model.UsageStats, error)
 }
 
 type Repository struct {
 	UserRepository
+	SessionRepository
+	PasswordResetRepository
+	EmailVerificationRepository
+	TwoFactorRepository
+	AccessTokenRepository
+	UserIdentityRepository
+	SigningKeyRepository
+	LoginAttemptRepository
+	DataExportRepository
+	InvitationRepository
+	ListInviteRepository
+	ListTransferRepository
+	PlanRepository
+	TrashRepository
+	AuditRepository
+	StatsRepository
 	TodoListRepository
 	TodoItemRepository
 }
 
 func NewRepository(db *sqlx.DB) *Repository {
 	return &Repository{
-		UserRepository:     NewUserRepositoryPostgres(db),
-		TodoListRepository: NewTodoListRepositoryPostgres(db),
-		TodoItemRepository: NewTodoItemRepositoryPostgres(db),
+		UserRepository:              NewUserRepositoryPostgres(db),
+		SessionRepository:           NewSessionRepositoryPostgres(db),
+		PasswordResetRepository:     NewPasswordResetRepositoryPostgres(db),
+		EmailVerificationRepository: NewEmailVerificationRepositoryPostgres(db),
+		TwoFactorRepository:         NewTwoFactorRepositoryPostgres(db),
+		AccessTokenRepository:       NewAccessTokenRepositoryPostgres(db),
+		UserIdentityRepository:      NewUserIdentityRepositoryPostgres(db),
+		SigningKeyRepository:        NewSigningKeyRepositoryPostgres(db),
+		LoginAttemptRepository:      NewLoginAttemptRepositoryPostgres(db),
+		DataExportRepository:        NewDataExportRepositoryPostgres(db),
+		InvitationRepository:        NewInvitationRepositoryPostgres(db),
+		ListInviteRepository:        NewListInviteRepositoryPostgres(db),
+		ListTransferRepository:      NewListTransferRepositoryPostgres(db),
+		PlanRepository:              NewPlanRepositoryPostgres(db),
+		TrashRepository:             NewTrashRepositoryPostgres(db),
+		AuditRepository:             NewAuditRepositoryPostgres(db),
+		StatsRepository:             NewStatsRepositoryPostgres(db),
+		TodoListRepository:          NewTodoListRepositoryPostgres(db),
+		TodoItemRepository:          NewTodoItemRepositoryPostgres(db),
 	}
 }
+
+// checkRowsAffected reports sql.ErrNoRows when a statement did not touch any row.
+func checkRowsAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const sessionsTable = "sessions"
+
+type SessionRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewSessionRepositoryPostgres(db *sqlx.DB) SessionRepository {
+	return &SessionRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *SessionRepositoryPostgres) Create(session model.Session) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, refresh_token_hash, created_at, expires_at, user_agent, ip, last_seen_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, sessionsTable)
+
+	_, err := r.db.Exec(query, session.ID, session.UserID, session.RefreshTokenHash, session.CreatedAt,
+		session.ExpiresAt, session.UserAgent, session.IP, session.LastSeenAt)
+
+	return err
+}
+
+func (r *SessionRepositoryPostgres) GetByID(sessionID uuid.UUID) (*model.Session, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, refresh_token_hash, created_at, expires_at, revoked_at, user_agent, ip, last_seen_at
+		FROM %s
+		WHERE id = $1
+	`, sessionsTable)
+
+	var session model.Session
+
+	return &session, r.db.Get(&session, query, sessionID)
+}
+
+func (r *SessionRepositoryPostgres) GetByRefreshTokenHash(hash string) (*model.Session, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, refresh_token_hash, created_at, expires_at, revoked_at, user_agent, ip, last_seen_at
+		FROM %s
+		WHERE refresh_token_hash = $1
+	`, sessionsTable)
+
+	var session model.Session
+
+	return &session, r.db.Get(&session, query, hash)
+}
+
+func (r *SessionRepositoryPostgres) GetAllActive(userID uuid.UUID) ([]model.Session, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, refresh_token_hash, created_at, expires_at, revoked_at, user_agent, ip, last_seen_at
+		FROM %s
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > $2
+		ORDER BY last_seen_at DESC
+	`, sessionsTable)
+
+	var sessions []model.Session
+
+	return sessions, r.db.Select(&sessions, query, userID, time.Now().UTC())
+}
+
+// Rotate replaces the refresh token of an active session. The old hash is part of
+// the condition, so only one of several concurrent refreshes with the same token wins.
+func (r *SessionRepositoryPostgres) Rotate(sessionID uuid.UUID, oldHash, newHash string, expiresAt time.Time, metadata model.SessionMetadata) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET refresh_token_hash = $1, expires_at = $2, user_agent = $3, ip = $4, last_seen_at = $5
+		WHERE id = $6 AND refresh_token_hash = $7 AND revoked_at IS NULL
+	`, sessionsTable)
+
+	res, err := r.db.Exec(query, newHash, expiresAt, metadata.UserAgent, metadata.IP, time.Now().UTC(), sessionID, oldHash)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+func (r *SessionRepositoryPostgres) Revoke(sessionID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE id = $2 AND revoked_at IS NULL
+	`, sessionsTable)
+
+	_, err := r.db.Exec(query, time.Now().UTC(), sessionID)
+
+	return err
+}
+
+func (r *SessionRepositoryPostgres) RevokeByID(userID, sessionID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE id = $2 AND user_id = $3 AND revoked_at IS NULL
+	`, sessionsTable)
+
+	res, err := r.db.Exec(query, time.Now().UTC(), sessionID, userID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+func (r *SessionRepositoryPostgres) RevokeAll(userID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE user_id = $2 AND revoked_at IS NULL
+	`, sessionsTable)
+
+	_, err := r.db.Exec(query, time.Now().UTC(), userID)
+
+	return err
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const signingKeysTable = "signing_keys"
+
+type SigningKeyRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewSigningKeyRepositoryPostgres(db *sqlx.DB) SigningKeyRepository {
+	return &SigningKeyRepositoryPostgres{
+		db: db,
+	}
+}
+
+// GetAllValid returns the keys that may still verify tokens, newest first.
+func (r *SigningKeyRepositoryPostgres) GetAllValid() ([]model.SigningKey, error) {
+	query := fmt.Sprintf(`
+		SELECT id, algorithm, private_key, created_at, retired_at, expires_at
+		FROM %s
+		WHERE expires_at IS NULL OR expires_at > $1
+		ORDER BY created_at DESC
+	`, signingKeysTable)
+
+	var keys []model.SigningKey
+
+	return keys, r.db.Select(&keys, query, time.Now().UTC())
+}
+
+// Rotate stores the new key and retires the current one in a single transaction. When
+// another instance already retired the current key it reports sql.ErrNoRows and stores nothing.
+func (r *SigningKeyRepositoryPostgres) Rotate(key model.SigningKey, currentKeyID string, expiresAt time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if currentKeyID != "" {
+		retireQuery := fmt.Sprintf(`
+			UPDATE %s
+			SET retired_at = $1, expires_at = $2
+			WHERE id = $3 AND retired_at IS NULL
+		`, signingKeysTable)
+
+		res, err := tx.Exec(retireQuery, key.CreatedAt, expiresAt, currentKeyID)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := checkRowsAffected(res); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	createQuery := fmt.Sprintf(`
+		INSERT INTO %s (id, algorithm, private_key, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, signingKeysTable)
+
+	if _, err := tx.Exec(createQuery, key.ID, key.Algorithm, key.PrivateKey, key.CreatedAt); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+// Users created within this period count as new
+const newUsersPeriod = 30 * 24 * time.Hour
+
+type StatsRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewStatsRepositoryPostgres(db *sqlx.DB) StatsRepository {
+	return &StatsRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *StatsRepositoryPostgres) Get(now time.Time) (model.UsageStats, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			(SELECT COUNT(*) FROM %[1]s) AS users,
+			(SELECT COUNT(*) FROM %[1]s WHERE verified_at IS NOT NULL) AS verified_users,
+			(SELECT COUNT(*) FROM %[1]s WHERE disabled_at IS NOT NULL) AS disabled_users,
+			(SELECT COUNT(*) FROM %[1]s WHERE totp_enabled_at IS NOT NULL) AS two_factor_users,
+			(SELECT COUNT(*) FROM %[1]s WHERE created_at > $2) AS new_users,
+			(SELECT COUNT(*) FROM %[2]s WHERE revoked_at IS NULL AND expires_at > $1) AS active_sessions,
+			(SELECT COUNT(*) FROM %[3]s WHERE deleted_at IS NULL) AS lists,
+			(SELECT COUNT(*) FROM %[4]s WHERE deleted_at IS NULL) AS items,
+			(SELECT COUNT(*) FROM %[4]s WHERE deleted_at IS NULL AND completed) AS completed_items
+	`, usersTable, sessionsTable, todoListsTable, todoItemsTable)
+
+	var stats model.UsageStats
+
+	return stats, r.db.Get(&stats, query, now, now.Add(-newUsersPeriod))
+}
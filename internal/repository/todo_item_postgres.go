@@ -25,12 +25,19 @@ func NewTodoItemRepositoryPostgres(db *sqlx.DB) TodoItemRepository {
 	}
 }
 
+// Create stores the item in the list. It returns ErrQuotaExceeded when the list already holds
+// as many items as the plan of its owner allows.
 func (r *TodoItemRepositoryPostgres) Create(listID uuid.UUID, item model.CreateTodoItemDTO) (uuid.UUID, error) {
 	tx, err := r.db.Begin()
 	if err != nil {
 		return uuid.Nil, err
 	}
 
+	if err := checkItemQuota(tx, listID); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
 	createItemQuery := fmt.Sprintf(`
 		INSERT INTO %s (id, title, description, created_at, deadline, completed)
 		VALUES ($1, $2, $3, $4, $5, $6)
@@ -60,15 +67,19 @@ func (r *TodoItemRepositoryPostgres) GetAll(userID, listID uuid.UUID, pagination
 		SELECT ti.id, ti.title, ti.description, ti.created_at, ti.deadline, ti.completed
 		FROM %s ti
 		INNER JOIN %s li ON li.item_id = ti.id
+		INNER JOIN %s tl ON tl.id = li.list_id
 		INNER JOIN %s ul ON ul.list_id = li.list_id
-		WHERE ul.user_id = $1 AND li.list_id = $2
-    `, todoItemsTable, listsItemsTable, usersListsTable)
+		WHERE ul.user_id = $1 AND li.list_id = $2 AND ti.deleted_at IS NULL AND tl.deleted_at IS NULL
+    `, todoItemsTable, listsItemsTable, todoListsTable, usersListsTable)
 
 	if orderBy != nil {
 		query += fmt.Sprintf("ORDER BY ti.%s\n", *orderBy)
 	}
 
-	query += fmt.Sprintf("LIMIT %d OFFSET %d", pagination.Limit, pagination.Limit*(pagination.Page-1))
+	// Without pagination every item is returned, e.g. for data exports
+	if pagination != nil {
+		query += fmt.Sprintf("LIMIT %d OFFSET %d", pagination.Limit, pagination.Limit*(pagination.Page-1))
+	}
 
 	var items []model.TodoItem
 
@@ -80,14 +91,32 @@ func (r *TodoItemRepositoryPostgres) GetByID(userID, itemID uuid.UUID) (model.To
 		SELECT ti.id, ti.title, ti.description, ti.created_at, ti.deadline, ti.completed
 		FROM %s ti
 		INNER JOIN %s li ON li.item_id = ti.id
+		INNER JOIN %s tl ON tl.id = li.list_id
 		INNER JOIN %s ul ON ul.list_id = li.list_id
-		WHERE ul.user_id = $1 AND ti.id = $2
-    `, todoItemsTable, listsItemsTable, usersListsTable)
+		WHERE ul.user_id = $1 AND ti.id = $2 AND ti.deleted_at IS NULL AND tl.deleted_at IS NULL
+    `, todoItemsTable, listsItemsTable, todoListsTable, usersListsTable)
 	var item model.TodoItem
 
 	return item, r.db.Get(&item, query, userID, itemID)
 }
 
+// GetList returns the list the item belongs to with the role of the user on it, sql.ErrNoRows
+// if the user cannot see the item or it is in the trash.
+func (r *TodoItemRepositoryPostgres) GetList(userID, itemID uuid.UUID) (model.TodoList, error) {
+	query := fmt.Sprintf(`
+		SELECT tl.id, tl.title, tl.description, tl.created_at, tl.archived_at, ul.role
+		FROM %s ti
+		INNER JOIN %s li ON li.item_id = ti.id
+		INNER JOIN %s tl ON tl.id = li.list_id
+		INNER JOIN %s ul ON ul.list_id = li.list_id
+		WHERE ul.user_id = $1 AND ti.id = $2 AND ti.deleted_at IS NULL AND tl.deleted_at IS NULL
+    `, todoItemsTable, listsItemsTable, todoListsTable, usersListsTable)
+
+	var list model.TodoList
+
+	return list, r.db.Get(&list, query, userID, itemID)
+}
+
 func (r *TodoItemRepositoryPostgres) Update(userID, itemID uuid.UUID, data model.UpdateTodoItemDTO) error {
 	toUpdate := make([]string, 0)
 
@@ -126,6 +155,7 @@ func (r *TodoItemRepositoryPostgres) Update(userID, itemID uuid.UUID, data model
 		SET %s
 		FROM %s li, %s ul
 		WHERE ti.id = li.item_id AND li.list_id = ul.list_id AND ul.user_id = $%d AND ti.id = $%d
+			AND ti.deleted_at IS NULL
     `, todoItemsTable, updateQuery, listsItemsTable, usersListsTable, argsID, argsID+1)
 
 	_, err := r.db.Exec(query, args...)
@@ -133,14 +163,17 @@ func (r *TodoItemRepositoryPostgres) Update(userID, itemID uuid.UUID, data model
 	return err
 }
 
+// Delete moves the item to the trash.
 func (r *TodoItemRepositoryPostgres) Delete(userID, itemID uuid.UUID) error {
 	query := fmt.Sprintf(`
-		DELETE FROM %s ti
-		USING %s li, %s ul
-		WHERE ti.id = li.item_id AND li.list_id = ul.list_id AND ul.user_id = $1 AND ti.id = $2
+		UPDATE %s ti
+		SET deleted_at = $1
+		FROM %s li, %s ul
+		WHERE ti.id = li.item_id AND li.list_id = ul.list_id AND ul.user_id = $2 AND ti.id = $3
+			AND ti.deleted_at IS NULL
     `, todoItemsTable, listsItemsTable, usersListsTable)
 
-	_, err := r.db.Exec(query, userID, itemID)
+	_, err := r.db.Exec(query, time.Now().UTC(), userID, itemID)
 
 	return err
 }
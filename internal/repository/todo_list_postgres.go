@@ -25,12 +25,19 @@ func NewTodoListRepositoryPostgres(db *sqlx.DB) TodoListRepository {
 	}
 }
 
+// Create stores the list with the user as its owner. It returns ErrQuotaExceeded when the user
+// already owns as many lists as their plan allows.
 func (r *TodoListRepositoryPostgres) Create(userID uuid.UUID, list model.CreateTodoListDTO) (uuid.UUID, error) {
 	tx, err := r.db.Begin()
 	if err != nil {
 		return uuid.Nil, err
 	}
 
+	if err := checkListQuota(tx, userID); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
 	createListQuery := fmt.Sprintf(`
 		INSERT INTO %s (id, title, description, created_at)
 		VALUES ($1, $2, $3, $4)
@@ -43,11 +50,11 @@ func (r *TodoListRepositoryPostgres) Create(userID uuid.UUID, list model.CreateT
 	}
 
 	createUserListQuery := fmt.Sprintf(`
-		INSERT INTO %s (id, user_id, list_id)
-		VALUES ($1, $2, $3)
+		INSERT INTO %s (id, user_id, list_id, role)
+		VALUES ($1, $2, $3, $4)
     `, usersListsTable)
 
-	if _, err := tx.Exec(createUserListQuery, uuid.New(), userID, listID); err != nil {
+	if _, err := tx.Exec(createUserListQuery, uuid.New(), userID, listID, model.ListRoleOwner); err != nil {
 		tx.Rollback()
 		return uuid.Nil, err
 	}
@@ -55,14 +62,24 @@ func (r *TodoListRepositoryPostgres) Create(userID uuid.UUID, list model.CreateT
 	return listID, tx.Commit()
 }
 
-func (r *TodoListRepositoryPostgres) GetAll(userID uuid.UUID, orderBy *string) ([]model.TodoList, error) {
+// GetAll returns the lists of the user. When archived is set, only archived or only active
+// lists are returned.
+func (r *TodoListRepositoryPostgres) GetAll(userID uuid.UUID, archived *bool, orderBy *string) ([]model.TodoList, error) {
 	query := fmt.Sprintf(`
-		SELECT tl.id, tl.title, tl.description, tl.created_at
+		SELECT tl.id, tl.title, tl.description, tl.created_at, tl.archived_at, ul.role
 		FROM %s tl
 		INNER JOIN %s ul ON tl.id = ul.list_id
-		WHERE ul.user_id = $1
+		WHERE ul.user_id = $1 AND tl.deleted_at IS NULL
     `, todoListsTable, usersListsTable)
 
+	if archived != nil {
+		if *archived {
+			query += "AND tl.archived_at IS NOT NULL\n"
+		} else {
+			query += "AND tl.archived_at IS NULL\n"
+		}
+	}
+
 	if orderBy != nil {
 		query += fmt.Sprintf("ORDER BY tl.%s\n", *orderBy)
 	}
@@ -74,10 +91,10 @@ func (r *TodoListRepositoryPostgres) GetAll(userID uuid.UUID, orderBy *string) (
 
 func (r *TodoListRepositoryPostgres) GetByID(userID, listID uuid.UUID) (model.TodoList, error) {
 	query := fmt.Sprintf(`
-		SELECT tl.id, tl.title, tl.description, tl.created_at
+		SELECT tl.id, tl.title, tl.description, tl.created_at, tl.archived_at, ul.role
 		FROM %s tl
 		INNER JOIN %s ul ON tl.id = ul.list_id
-		WHERE ul.user_id = $1 AND ul.list_id = $2
+		WHERE ul.user_id = $1 AND ul.list_id = $2 AND tl.deleted_at IS NULL
     `, todoListsTable, usersListsTable)
 
 	var list model.TodoList
@@ -110,7 +127,7 @@ func (r *TodoListRepositoryPostgres) Update(userID, listID uuid.UUID, data model
 		UPDATE %s tl
 		SET %s
 		FROM %s ul
-		WHERE tl.id = ul.list_id AND ul.list_id = $%d AND ul.user_id = $%d
+		WHERE tl.id = ul.list_id AND ul.list_id = $%d AND ul.user_id = $%d AND tl.deleted_at IS NULL
     `, todoListsTable, updateQuery, usersListsTable, argsID, argsID+1)
 
 	_, err := r.db.Exec(query, args...)
@@ -118,14 +135,154 @@ func (r *TodoListRepositoryPostgres) Update(userID, listID uuid.UUID, data model
 	return err
 }
 
+// Delete moves the list to the trash if the user owns it, members cannot delete a shared list.
+// Its items go along with it and come back when the list is restored.
 func (r *TodoListRepositoryPostgres) Delete(userID, listID uuid.UUID) error {
 	query := fmt.Sprintf(`
-		DELETE FROM %s tl
-		USING %s ul
-		WHERE tl.id = ul.list_id AND ul.user_id = $1 AND ul.list_id = $2
+		UPDATE %s tl
+		SET deleted_at = $1
+		FROM %s ul
+		WHERE tl.id = ul.list_id AND ul.user_id = $2 AND ul.list_id = $3 AND ul.role = $4
+			AND tl.deleted_at IS NULL
     `, todoListsTable, usersListsTable)
 
-	_, err := r.db.Exec(query, userID, listID)
+	_, err := r.db.Exec(query, time.Now().UTC(), userID, listID, model.ListRoleOwner)
 
 	return err
 }
+
+// Archive marks the list as archived, sql.ErrNoRows if it already is.
+func (r *TodoListRepositoryPostgres) Archive(listID uuid.UUID, archivedAt time.Time) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET archived_at = $1
+		WHERE id = $2 AND archived_at IS NULL AND deleted_at IS NULL
+    `, todoListsTable)
+
+	res, err := r.db.Exec(query, archivedAt, listID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// Unarchive makes the archived list of the owner active again, sql.ErrNoRows if it is not
+// archived. It returns ErrQuotaExceeded when the owner already has as many active lists as
+// their plan allows.
+func (r *TodoListRepositoryPostgres) Unarchive(ownerID, listID uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := checkListQuota(tx, ownerID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET archived_at = NULL
+		WHERE id = $1 AND archived_at IS NOT NULL AND deleted_at IS NULL
+    `, todoListsTable)
+
+	res, err := tx.Exec(query, listID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetRole returns the role of the user on the list, sql.ErrNoRows if the user is not a member
+// or the list is in the trash.
+func (r *TodoListRepositoryPostgres) GetRole(userID, listID uuid.UUID) (string, error) {
+	query := fmt.Sprintf(`
+		SELECT ul.role
+		FROM %s ul
+		INNER JOIN %s tl ON tl.id = ul.list_id
+		WHERE ul.user_id = $1 AND ul.list_id = $2 AND tl.deleted_at IS NULL
+    `, usersListsTable, todoListsTable)
+
+	var role string
+
+	return role, r.db.Get(&role, query, userID, listID)
+}
+
+func (r *TodoListRepositoryPostgres) GetMembers(listID uuid.UUID) ([]model.ListMember, error) {
+	query := fmt.Sprintf(`
+		SELECT ul.user_id, u.username, u.email, ul.role, ul.created_at
+		FROM %s ul
+		INNER JOIN %s u ON u.id = ul.user_id
+		WHERE ul.list_id = $1
+		ORDER BY ul.role = $2 DESC, ul.created_at
+    `, usersListsTable, usersTable)
+
+	var members []model.ListMember
+
+	return members, r.db.Select(&members, query, listID, model.ListRoleOwner)
+}
+
+// AddMember shares the list with the user. It returns ErrQuotaExceeded when the list already
+// has as many members as the plan of its owner allows.
+func (r *TodoListRepositoryPostgres) AddMember(listID, userID uuid.UUID, role string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := checkMemberQuota(tx, listID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, list_id, role, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+    `, usersListsTable)
+
+	if _, err := tx.Exec(query, uuid.New(), userID, listID, role, time.Now().UTC()); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateMemberRole changes the role of a member. The role of the owner cannot be changed.
+func (r *TodoListRepositoryPostgres) UpdateMemberRole(listID, userID uuid.UUID, role string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET role = $1
+		WHERE list_id = $2 AND user_id = $3 AND role != $4
+    `, usersListsTable)
+
+	res, err := r.db.Exec(query, role, listID, userID, model.ListRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// RemoveMember takes the user off the list. The owner cannot be removed.
+func (r *TodoListRepositoryPostgres) RemoveMember(listID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE list_id = $1 AND user_id = $2 AND role != $3
+    `, usersListsTable)
+
+	res, err := r.db.Exec(query, listID, userID, model.ListRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
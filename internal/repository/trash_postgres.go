@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+type TrashRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewTrashRepositoryPostgres(db *sqlx.DB) TrashRepository {
+	return &TrashRepositoryPostgres{
+		db: db,
+	}
+}
+
+// trashQuery selects the deleted lists the user owns and the deleted items of the lists the
+// user can edit, as long as the list itself is not deleted.
+func trashQuery() string {
+	return fmt.Sprintf(`
+		SELECT *
+		FROM (
+			SELECT tl.id, '%[5]s' AS type, tl.title, NULL::UUID AS list_id, tl.deleted_at,
+				tl.archived_at AS list_archived_at
+			FROM %[1]s tl
+			INNER JOIN %[2]s ul ON ul.list_id = tl.id
+			WHERE ul.user_id = $1 AND ul.role = $2 AND tl.deleted_at IS NOT NULL
+			UNION ALL
+			SELECT ti.id, '%[6]s' AS type, ti.title, li.list_id, ti.deleted_at, tl.archived_at AS list_archived_at
+			FROM %[3]s ti
+			INNER JOIN %[4]s li ON li.item_id = ti.id
+			INNER JOIN %[1]s tl ON tl.id = li.list_id
+			INNER JOIN %[2]s ul ON ul.list_id = li.list_id
+			WHERE ul.user_id = $1 AND ul.role IN ($2, $3) AND ti.deleted_at IS NOT NULL AND tl.deleted_at IS NULL
+		) AS trash
+	`, todoListsTable, usersListsTable, todoItemsTable, listsItemsTable, model.TrashTypeList, model.TrashTypeItem)
+}
+
+// GetAll returns the trash of the user, most recently deleted first.
+func (r *TrashRepositoryPostgres) GetAll(userID uuid.UUID) ([]model.TrashEntry, error) {
+	query := trashQuery() + "ORDER BY deleted_at DESC, id"
+
+	var entries []model.TrashEntry
+
+	return entries, r.db.Select(&entries, query, userID, model.ListRoleOwner, model.ListRoleEditor)
+}
+
+// GetByID returns an entry of the user's trash, sql.ErrNoRows if it is not there.
+func (r *TrashRepositoryPostgres) GetByID(userID, entryID uuid.UUID) (model.TrashEntry, error) {
+	query := trashQuery() + "WHERE id = $4"
+
+	var entry model.TrashEntry
+
+	return entry, r.db.Get(&entry, query, userID, model.ListRoleOwner, model.ListRoleEditor, entryID)
+}
+
+// RestoreList takes the list of the owner out of the trash, sql.ErrNoRows if it is not deleted.
+// It returns ErrQuotaExceeded when an active list would take the owner over the quota of their plan.
+func (r *TrashRepositoryPostgres) RestoreList(ownerID, listID uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	getListQuery := fmt.Sprintf(`
+		SELECT archived_at
+		FROM %s
+		WHERE id = $1 AND deleted_at IS NOT NULL
+		FOR UPDATE
+	`, todoListsTable)
+
+	var archivedAt *time.Time
+	if err := tx.QueryRow(getListQuery, listID).Scan(&archivedAt); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Archived lists do not count towards the quota
+	if archivedAt == nil {
+		if err := checkListQuota(tx, ownerID); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	restoreQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET deleted_at = NULL
+		WHERE id = $1
+	`, todoListsTable)
+
+	if _, err := tx.Exec(restoreQuery, listID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RestoreItem takes the item out of the trash, sql.ErrNoRows if it is not deleted. It returns
+// ErrQuotaExceeded when the list already holds as many items as the plan of its owner allows.
+func (r *TrashRepositoryPostgres) RestoreItem(listID, itemID uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := checkItemQuota(tx, listID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	restoreQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET deleted_at = NULL
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`, todoItemsTable)
+
+	res, err := tx.Exec(restoreQuery, itemID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Purge permanently removes the lists and items deleted before deletedBefore, together with
+// the items of the purged lists.
+func (r *TrashRepositoryPostgres) Purge(deletedBefore time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	deleteListItemsQuery := fmt.Sprintf(`
+		DELETE FROM %s ti
+		USING %s li, %s tl
+		WHERE ti.id = li.item_id AND li.list_id = tl.id AND tl.deleted_at < $1
+	`, todoItemsTable, listsItemsTable, todoListsTable)
+
+	if _, err := tx.Exec(deleteListItemsQuery, deletedBefore); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deleteListsQuery := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE deleted_at < $1
+	`, todoListsTable)
+
+	if _, err := tx.Exec(deleteListsQuery, deletedBefore); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deleteItemsQuery := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE deleted_at < $1
+	`, todoItemsTable)
+
+	if _, err := tx.Exec(deleteItemsQuery, deletedBefore); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
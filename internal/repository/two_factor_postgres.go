@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const recoveryCodesTable = "recovery_codes"
+
+type TwoFactorRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewTwoFactorRepositoryPostgres(db *sqlx.DB) TwoFactorRepository {
+	return &TwoFactorRepositoryPostgres{
+		db: db,
+	}
+}
+
+// SetSecret stores a pending secret. It fails with sql.ErrNoRows once 2FA is enabled,
+// so an enrolled secret can't be replaced without disabling it first.
+func (r *TwoFactorRepositoryPostgres) SetSecret(userID uuid.UUID, secret string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET totp_secret = $1, totp_last_step = 0
+		WHERE id = $2 AND totp_enabled_at IS NULL
+	`, usersTable)
+
+	res, err := r.db.Exec(query, secret, userID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// Enable turns 2FA on and replaces all recovery codes of the user.
+func (r *TwoFactorRepositoryPostgres) Enable(userID uuid.UUID, step int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	enableQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET totp_enabled_at = $1, totp_last_step = $2
+		WHERE id = $3 AND totp_enabled_at IS NULL AND totp_secret IS NOT NULL
+	`, usersTable)
+
+	res, err := tx.Exec(enableQuery, now, step, userID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deleteCodesQuery := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE user_id = $1
+	`, recoveryCodesTable)
+
+	if _, err := tx.Exec(deleteCodesQuery, userID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	createCodeQuery := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, code_hash, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, recoveryCodesTable)
+
+	for _, hash := range recoveryCodeHashes {
+		if _, err := tx.Exec(createCodeQuery, uuid.New(), userID, hash, now); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *TwoFactorRepositoryPostgres) Disable(userID uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	disableQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET totp_secret = NULL, totp_enabled_at = NULL, totp_last_step = 0
+		WHERE id = $1
+	`, usersTable)
+
+	if _, err := tx.Exec(disableQuery, userID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deleteCodesQuery := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE user_id = $1
+	`, recoveryCodesTable)
+
+	if _, err := tx.Exec(deleteCodesQuery, userID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UseStep records the time step of an accepted code. It fails with sql.ErrNoRows if
+// the same or a later step was used already, which rejects replayed codes.
+func (r *TwoFactorRepositoryPostgres) UseStep(userID uuid.UUID, step int64) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET totp_last_step = $1
+		WHERE id = $2 AND totp_last_step < $1
+	`, usersTable)
+
+	res, err := r.db.Exec(query, step, userID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+func (r *TwoFactorRepositoryPostgres) UseRecoveryCode(userID uuid.UUID, codeHash string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET used_at = $1
+		WHERE user_id = $2 AND code_hash = $3 AND used_at IS NULL
+	`, recoveryCodesTable)
+
+	res, err := r.db.Exec(query, time.Now().UTC(), userID, codeHash)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
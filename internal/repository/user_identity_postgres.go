@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rtsoy/todo-app/internal/model"
+)
+
+const userIdentitiesTable = "user_identities"
+
+type UserIdentityRepositoryPostgres struct {
+	db *sqlx.DB
+}
+
+func NewUserIdentityRepositoryPostgres(db *sqlx.DB) UserIdentityRepository {
+	return &UserIdentityRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *UserIdentityRepositoryPostgres) Create(identity model.UserIdentity) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, provider, subject, email, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, userIdentitiesTable)
+
+	_, err := r.db.Exec(query, identity.ID, identity.UserID, identity.Provider, identity.Subject,
+		identity.Email, identity.CreatedAt)
+
+	return err
+}
+
+func (r *UserIdentityRepositoryPostgres) GetBySubject(provider, subject string) (*model.UserIdentity, error) {
+	query := fmt.Sprintf(`
+		SELECT id, user_id, provider, subject, email, created_at
+		FROM %s
+		WHERE provider = $1 AND subject = $2
+	`, userIdentitiesTable)
+
+	var identity model.UserIdentity
+
+	return &identity, r.db.Get(&identity, query, provider, subject)
+}
+
+// CreateWithUser registers a user that signs in through an identity provider only.
+// Such users have no usable password until they reset it.
+func (r *UserIdentityRepositoryPostgres) CreateWithUser(user model.User, identity model.UserIdentity) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	createUserQuery := fmt.Sprintf(`
+		INSERT INTO %s (id, email, username, password_hash, verified_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, usersTable)
+
+	if _, err := tx.Exec(createUserQuery, user.ID, user.Email, user.Username, user.PasswordHash,
+		user.VerifiedAt); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	createIdentityQuery := fmt.Sprintf(`
+		INSERT INTO %s (id, user_id, provider, subject, email, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, userIdentitiesTable)
+
+	if _, err := tx.Exec(createIdentityQuery, identity.ID, identity.UserID, identity.Provider, identity.Subject,
+		identity.Email, identity.CreatedAt); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -2,9 +2,12 @@ package repository
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/rtsoy/todo-app/internal/model"
 )
 
@@ -20,11 +23,25 @@ func NewUserRepositoryPostgres(db *sqlx.DB) UserRepository {
 	}
 }
 
+func (r *UserRepositoryPostgres) GetByID(userID uuid.UUID) (*model.User, error) {
+	query := fmt.Sprintf(`
+		SELECT id, email, username, password_hash, verified_at, role, plan, disabled_at, created_at,
+			totp_secret, totp_enabled_at, totp_last_step
+		FROM %s
+		WHERE id = $1
+	`, usersTable)
+
+	var user model.User
+
+	return &user, r.db.Get(&user, query, userID)
+}
+
 func (r *UserRepositoryPostgres) GetByEmail(email string) (*model.User, error) {
 	query := fmt.Sprintf(`
-		SELECT id, email, username, password_hash
+		SELECT id, email, username, password_hash, verified_at, role, plan, disabled_at, created_at,
+			totp_secret, totp_enabled_at, totp_last_step
 		FROM %s
-		WHERE email = $1
+		WHERE email = LOWER($1)
 	`, usersTable)
 
 	var user model.User
@@ -32,6 +49,21 @@ func (r *UserRepositoryPostgres) GetByEmail(email string) (*model.User, error) {
 	return &user, r.db.Get(&user, query, email)
 }
 
+// GetByLogin finds the user by email address or username, both are stored in lower case.
+// Usernames cannot contain "@", so a login never matches two users.
+func (r *UserRepositoryPostgres) GetByLogin(login string) (*model.User, error) {
+	query := fmt.Sprintf(`
+		SELECT id, email, username, password_hash, verified_at, role, plan, disabled_at, created_at,
+			totp_secret, totp_enabled_at, totp_last_step
+		FROM %s
+		WHERE email = LOWER($1) OR username = LOWER($1)
+	`, usersTable)
+
+	var user model.User
+
+	return &user, r.db.Get(&user, query, login)
+}
+
 func (r *UserRepositoryPostgres) Create(user model.CreateUserDTO) (uuid.UUID, error) {
 	query := fmt.Sprintf(`
 		INSERT INTO %s (id, email, username, password_hash)
@@ -42,3 +74,318 @@ func (r *UserRepositoryPostgres) Create(user model.CreateUserDTO) (uuid.UUID, er
 
 	return id, r.db.QueryRow(query, id, user.Email, user.Username, user.Password).Err()
 }
+
+// CreateWithInvitation uses up one use of a valid invitation and creates the user in the
+// same transaction, so a failed sign-up does not cost the invitation a use. It returns
+// sql.ErrNoRows if the code is unknown, revoked, expired or used up.
+func (r *UserRepositoryPostgres) CreateWithInvitation(user model.CreateUserDTO, codeHash string,
+	now time.Time) (uuid.UUID, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	useInvitationQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET uses = uses + 1
+		WHERE code_hash = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > $2)
+			AND uses < max_uses
+	`, invitationsTable)
+
+	res, err := tx.Exec(useInvitationQuery, codeHash, now)
+	if err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	createUserQuery := fmt.Sprintf(`
+		INSERT INTO %s (id, email, username, password_hash)
+		VALUES ($1, $2, $3, $4)
+	`, usersTable)
+
+	id := uuid.New()
+
+	if _, err := tx.Exec(createUserQuery, id, user.Email, user.Username, user.Password); err != nil {
+		tx.Rollback()
+		return uuid.Nil, err
+	}
+
+	return id, tx.Commit()
+}
+
+func (r *UserRepositoryPostgres) UpdateUsername(userID uuid.UUID, username string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET username = $1
+		WHERE id = $2
+	`, usersTable)
+
+	res, err := r.db.Exec(query, username, userID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// UpdatePassword sets the new password hash and signs out every session except the current one.
+func (r *UserRepositoryPostgres) UpdatePassword(userID, currentSessionID uuid.UUID, passwordHash string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	updatePasswordQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET password_hash = $1
+		WHERE id = $2
+	`, usersTable)
+
+	res, err := tx.Exec(updatePasswordQuery, passwordHash, userID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	revokeSessionsQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE user_id = $2 AND id != $3 AND revoked_at IS NULL
+	`, sessionsTable)
+
+	if _, err := tx.Exec(revokeSessionsQuery, time.Now().UTC(), userID, currentSessionID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdatePasswordHash replaces the hash of an unchanged password, e.g. after the hashing
+// algorithm was upgraded. Nothing is updated if the password was changed in the meantime.
+func (r *UserRepositoryPostgres) UpdatePasswordHash(userID uuid.UUID, oldHash, newHash string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET password_hash = $1
+		WHERE id = $2 AND password_hash = $3
+	`, usersTable)
+
+	res, err := r.db.Exec(query, newHash, userID, oldHash)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// Delete removes the user together with the lists they own and their items. Everything else that
+// belongs to the user (sessions, tokens, identities, memberships of shared lists, ...) is removed
+// by ON DELETE CASCADE, but lists and items are only linked through users_lists and lists_items
+// and have to go first.
+func (r *UserRepositoryPostgres) Delete(userID uuid.UUID) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	deleteItemsQuery := fmt.Sprintf(`
+		DELETE FROM %s ti
+		USING %s li, %s ul
+		WHERE ti.id = li.item_id AND li.list_id = ul.list_id AND ul.user_id = $1 AND ul.role = $2
+	`, todoItemsTable, listsItemsTable, usersListsTable)
+
+	if _, err := tx.Exec(deleteItemsQuery, userID, model.ListRoleOwner); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deleteListsQuery := fmt.Sprintf(`
+		DELETE FROM %s tl
+		USING %s ul
+		WHERE tl.id = ul.list_id AND ul.user_id = $1 AND ul.role = $2
+	`, todoListsTable, usersListsTable)
+
+	if _, err := tx.Exec(deleteListsQuery, userID, model.ListRoleOwner); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deleteUserQuery := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE id = $1
+	`, usersTable)
+
+	res, err := tx.Exec(deleteUserQuery, userID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Search returns users whose email or username contains the search string, newest first.
+func (r *UserRepositoryPostgres) Search(search string, pagination model.Pagination) ([]model.User, error) {
+	query := fmt.Sprintf(`
+		SELECT id, email, username, password_hash, verified_at, role, plan, disabled_at, created_at,
+			totp_secret, totp_enabled_at, totp_last_step
+		FROM %s
+		WHERE email ILIKE '%%' || $1 || '%%' OR username ILIKE '%%' || $1 || '%%'
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`, usersTable)
+
+	var users []model.User
+
+	return users, r.db.Select(&users, query, escapeLike(search), pagination.Limit, pagination.Limit*(pagination.Page-1))
+}
+
+// SetRole changes the role and signs the user out, so no token carries the old role.
+func (r *UserRepositoryPostgres) SetRole(userID uuid.UUID, role string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	setRoleQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET role = $1
+		WHERE id = $2
+	`, usersTable)
+
+	res, err := tx.Exec(setRoleQuery, role, userID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	revokeSessionsQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE user_id = $2 AND revoked_at IS NULL
+	`, sessionsTable)
+
+	if _, err := tx.Exec(revokeSessionsQuery, time.Now().UTC(), userID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SetRoleByEmail changes the role of the users with the given addresses, unknown addresses are skipped.
+func (r *UserRepositoryPostgres) SetRoleByEmail(emails []string, role string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET role = $1
+		WHERE email = ANY($2)
+	`, usersTable)
+
+	_, err := r.db.Exec(query, role, pq.Array(emails))
+
+	return err
+}
+
+func (r *UserRepositoryPostgres) SetPlan(userID uuid.UUID, plan string) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET plan = $1
+		WHERE id = $2
+	`, usersTable)
+
+	res, err := r.db.Exec(query, plan, userID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// Disable blocks the account and revokes its sessions and personal access tokens.
+func (r *UserRepositoryPostgres) Disable(userID uuid.UUID, disabledAt time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	disableQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET disabled_at = $1
+		WHERE id = $2 AND disabled_at IS NULL
+	`, usersTable)
+
+	res, err := tx.Exec(disableQuery, disabledAt, userID)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := checkRowsAffected(res); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	revokeSessionsQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE user_id = $2 AND revoked_at IS NULL
+	`, sessionsTable)
+
+	if _, err := tx.Exec(revokeSessionsQuery, disabledAt, userID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	revokeTokensQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET revoked_at = $1
+		WHERE user_id = $2 AND revoked_at IS NULL
+	`, personalAccessTokensTable)
+
+	if _, err := tx.Exec(revokeTokensQuery, disabledAt, userID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *UserRepositoryPostgres) Enable(userID uuid.UUID) error {
+	query := fmt.Sprintf(`
+		UPDATE %s
+		SET disabled_at = NULL
+		WHERE id = $1 AND disabled_at IS NOT NULL
+	`, usersTable)
+
+	res, err := r.db.Exec(query, userID)
+	if err != nil {
+		return err
+	}
+
+	return checkRowsAffected(res)
+}
+
+// escapeLike makes wildcards in user input match literally in a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+const (
+	// AccessTokenPrefix tells personal access tokens apart from session JWTs
+	AccessTokenPrefix = "tdo_"
+
+	accessTokenLength        = 32
+	minAccessTokenNameLength = 3
+	maxAccessTokenNameLength = 255
+)
+
+type AccessTokenService struct {
+	repository repository.AccessTokenRepository
+}
+
+func NewAccessTokenService(repository repository.AccessTokenRepository) AccessTokenServicer {
+	return &AccessTokenService{
+		repository: repository,
+	}
+}
+
+func (s *AccessTokenService) Create(userID uuid.UUID, input model.CreatePersonalAccessTokenDTO) (model.CreatedPersonalAccessToken, error) {
+	name := strings.TrimSpace(input.Name)
+	if len(name) < minAccessTokenNameLength {
+		return model.CreatedPersonalAccessToken{}, errors.New("name length is too short")
+	}
+
+	if len(name) > maxAccessTokenNameLength {
+		return model.CreatedPersonalAccessToken{}, errors.New("name length is too long")
+	}
+
+	if len(input.Scopes) == 0 {
+		return model.CreatedPersonalAccessToken{}, errors.New("at least one scope is required")
+	}
+
+	for _, scope := range input.Scopes {
+		if !isScopeValid(scope) {
+			return model.CreatedPersonalAccessToken{}, errors.New("unknown scope: " + scope)
+		}
+	}
+
+	input.ExpiresAt = toUTC(input.ExpiresAt)
+
+	now := time.Now().UTC()
+	if input.ExpiresAt != nil && now.After(*input.ExpiresAt) {
+		return model.CreatedPersonalAccessToken{}, errors.New("expiration cannot be in the past")
+	}
+
+	secret, err := generateRandomToken(accessTokenLength)
+	if err != nil {
+		return model.CreatedPersonalAccessToken{}, err
+	}
+
+	plainToken := AccessTokenPrefix + secret
+
+	token := model.PersonalAccessToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hashToken(plainToken),
+		Scopes:    input.Scopes,
+		CreatedAt: now,
+		ExpiresAt: input.ExpiresAt,
+	}
+
+	if err := s.repository.Create(token); err != nil {
+		return model.CreatedPersonalAccessToken{}, err
+	}
+
+	return model.CreatedPersonalAccessToken{PersonalAccessToken: token, Token: plainToken}, nil
+}
+
+func (s *AccessTokenService) GetAll(userID uuid.UUID) ([]model.PersonalAccessToken, error) {
+	return s.repository.GetAll(userID)
+}
+
+func (s *AccessTokenService) Revoke(userID, tokenID uuid.UUID) error {
+	if err := s.repository.Revoke(userID, tokenID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("access token not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Authenticate resolves a plain personal access token and records its use.
+func (s *AccessTokenService) Authenticate(plainToken string) (*model.PersonalAccessToken, error) {
+	token, err := s.repository.GetByTokenHash(hashToken(plainToken))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("invalid access token")
+		}
+
+		return nil, err
+	}
+
+	if token.RevokedAt != nil {
+		return nil, errors.New("access token has been revoked")
+	}
+
+	if token.ExpiresAt != nil && time.Now().UTC().After(*token.ExpiresAt) {
+		return nil, errors.New("access token is expired")
+	}
+
+	if err := s.repository.Touch(token.ID); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func isScopeValid(scope string) bool {
+	for _, s := range model.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
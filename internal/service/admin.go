@@ -0,0 +1,202 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+const (
+	adminUsersDefaultLimit = 20
+	adminUsersMaxLimit     = 100
+)
+
+type AdminService struct {
+	userRepository      repository.UserRepository
+	twoFactorRepository repository.TwoFactorRepository
+	statsRepository     repository.StatsRepository
+}
+
+func NewAdminService(userRepository repository.UserRepository, twoFactorRepository repository.TwoFactorRepository,
+	statsRepository repository.StatsRepository) AdminServicer {
+	return &AdminService{
+		userRepository:      userRepository,
+		twoFactorRepository: twoFactorRepository,
+		statsRepository:     statsRepository,
+	}
+}
+
+// PromoteAdmins gives the admin role to the configured addresses, it runs on startup
+// so the first admin does not have to be created by hand.
+func (s *AdminService) PromoteAdmins(emails []string) error {
+	var normalized []string
+	for _, email := range emails {
+		if email = normalizeLogin(email); email != "" {
+			normalized = append(normalized, email)
+		}
+	}
+
+	if len(normalized) == 0 {
+		return nil
+	}
+
+	return s.userRepository.SetRoleByEmail(normalized, model.RoleAdmin)
+}
+
+func (s *AdminService) GetUsers(search string, pagination *model.Pagination) ([]model.User, error) {
+	if pagination.Limit <= 0 {
+		pagination.Limit = adminUsersDefaultLimit
+	}
+
+	if pagination.Limit > adminUsersMaxLimit {
+		pagination.Limit = adminUsersMaxLimit
+	}
+
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+
+	users, err := s.userRepository.Search(strings.TrimSpace(search), *pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	if users == nil {
+		users = []model.User{}
+	}
+
+	return users, nil
+}
+
+func (s *AdminService) GetUser(userID uuid.UUID) (*model.User, error) {
+	user, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("user not found")
+		}
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// SetRole changes the role of another user. Admins cannot change their own role,
+// which also keeps at least one admin around.
+func (s *AdminService) SetRole(actorID, userID uuid.UUID, input model.SetRoleDTO) error {
+	if !isRoleValid(input.Role) {
+		return errors.New("role is not valid")
+	}
+
+	if actorID == userID {
+		return errors.New("you cannot change your own role")
+	}
+
+	if err := s.userRepository.SetRole(userID, input.Role); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("user not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// SetPlan moves the user to another plan. Moving to a smaller plan keeps what the user
+// already has, they just cannot add more until they are under its quotas.
+func (s *AdminService) SetPlan(userID uuid.UUID, input model.SetPlanDTO) error {
+	if !isPlanValid(input.Plan) {
+		return errors.New("plan is not valid")
+	}
+
+	if err := s.userRepository.SetPlan(userID, input.Plan); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("user not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *AdminService) Disable(actorID, userID uuid.UUID) error {
+	if actorID == userID {
+		return errors.New("you cannot disable your own account")
+	}
+
+	if _, err := s.GetUser(userID); err != nil {
+		return err
+	}
+
+	if err := s.userRepository.Disable(userID, time.Now().UTC()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("account is already disabled")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *AdminService) Enable(userID uuid.UUID) error {
+	if _, err := s.GetUser(userID); err != nil {
+		return err
+	}
+
+	if err := s.userRepository.Enable(userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("account is not disabled")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// ResetTwoFactor turns off two-factor authentication for a user who lost both the
+// authenticator and the recovery codes.
+func (s *AdminService) ResetTwoFactor(userID uuid.UUID) error {
+	user, err := s.GetUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if user.TOTPSecret == nil {
+		return errors.New("two-factor authentication is not enabled")
+	}
+
+	return s.twoFactorRepository.Disable(userID)
+}
+
+func (s *AdminService) GetStats() (model.UsageStats, error) {
+	return s.statsRepository.Get(time.Now().UTC())
+}
+
+func isRoleValid(role string) bool {
+	for _, r := range model.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPlanValid(plan string) bool {
+	for _, p := range model.Plans {
+		if p == plan {
+			return true
+		}
+	}
+
+	return false
+}
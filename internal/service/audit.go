@@ -0,0 +1,162 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+const (
+	auditDefaultLimit        = 50
+	auditMaxLimit            = 200
+	maxAuditTargetLength     = 255
+	maxAuditReasonLength     = 1024
+	maxAuditIPLength         = 64
+	maxAuditActionLength     = 64
+	maxAuditTargetTypeLength = 32
+)
+
+type AuditService struct {
+	repository     repository.AuditRepository
+	userRepository repository.UserRepository
+}
+
+func NewAuditService(repository repository.AuditRepository, userRepository repository.UserRepository) AuditServicer {
+	return &AuditService{
+		repository:     repository,
+		userRepository: userRepository,
+	}
+}
+
+// Record appends the event. Without a UserID the event is attributed to the target user,
+// the account a sign-in login belongs to, or else to the actor.
+func (s *AuditService) Record(event model.AuditEvent) error {
+	event.ID = uuid.New()
+	event.CreatedAt = time.Now().UTC()
+
+	if event.UserID == nil {
+		userID, err := s.resolveUser(event)
+		if err != nil {
+			return err
+		}
+
+		event.UserID = userID
+	}
+
+	event.Action = truncate(event.Action, maxAuditActionLength)
+	event.TargetType = truncate(event.TargetType, maxAuditTargetTypeLength)
+	event.TargetID = truncate(event.TargetID, maxAuditTargetLength)
+	event.IP = truncate(event.IP, maxAuditIPLength)
+	event.UserAgent = truncate(event.UserAgent, maxUserAgentLength)
+	event.Reason = truncate(event.Reason, maxAuditReasonLength)
+
+	return s.repository.Create(event)
+}
+
+func (s *AuditService) resolveUser(event model.AuditEvent) (*uuid.UUID, error) {
+	switch event.TargetType {
+	case model.AuditTargetUser:
+		if userID, err := uuid.Parse(event.TargetID); err == nil {
+			return &userID, nil
+		}
+	case model.AuditTargetLogin:
+		user, err := s.userRepository.GetByLogin(normalizeLogin(event.TargetID))
+		if err == nil {
+			return &user.ID, nil
+		}
+
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+
+	return event.ActorID, nil
+}
+
+// GetForUser returns the events concerning the account, newest first.
+func (s *AuditService) GetForUser(userID uuid.UUID, pagination *model.Pagination) ([]model.AuditEvent, error) {
+	return s.find(model.AuditFilter{UserID: &userID}, pagination)
+}
+
+func (s *AuditService) Find(input model.AuditFilterDTO, pagination *model.Pagination) ([]model.AuditEvent, error) {
+	var filter model.AuditFilter
+
+	if input.ActorID != "" {
+		actorID, err := uuid.Parse(input.ActorID)
+		if err != nil {
+			return nil, errors.New("invalid actor id")
+		}
+
+		filter.ActorID = &actorID
+	}
+
+	if input.UserID != "" {
+		userID, err := uuid.Parse(input.UserID)
+		if err != nil {
+			return nil, errors.New("invalid user id")
+		}
+
+		filter.UserID = &userID
+	}
+
+	filter.Action = strings.TrimSpace(input.Action)
+
+	switch input.Outcome {
+	case "", model.AuditOutcomeSuccess, model.AuditOutcomeFailure:
+		filter.Outcome = input.Outcome
+	default:
+		return nil, errors.New("outcome must be success or failure")
+	}
+
+	if input.From != "" {
+		from, err := time.Parse(time.RFC3339, input.From)
+		if err != nil {
+			return nil, errors.New("from must be an RFC 3339 time")
+		}
+
+		from = from.UTC()
+		filter.From = &from
+	}
+
+	if input.To != "" {
+		to, err := time.Parse(time.RFC3339, input.To)
+		if err != nil {
+			return nil, errors.New("to must be an RFC 3339 time")
+		}
+
+		to = to.UTC()
+		filter.To = &to
+	}
+
+	return s.find(filter, pagination)
+}
+
+func (s *AuditService) find(filter model.AuditFilter, pagination *model.Pagination) ([]model.AuditEvent, error) {
+	if pagination.Limit <= 0 {
+		pagination.Limit = auditDefaultLimit
+	}
+
+	if pagination.Limit > auditMaxLimit {
+		pagination.Limit = auditMaxLimit
+	}
+
+	if pagination.Page <= 0 {
+		pagination.Page = 1
+	}
+
+	events, err := s.repository.Find(filter, *pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	if events == nil {
+		events = []model.AuditEvent{}
+	}
+
+	return events, nil
+}
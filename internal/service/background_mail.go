@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rtsoy/todo-app/pkg/mail"
+	"github.com/sirupsen/logrus"
+)
+
+// BackgroundMailer sends mail outside of the request, so neither a slow nor a failing mail
+// server shows in the response. Failures can only be logged.
+type BackgroundMailer struct {
+	mailer mail.Sender
+	log    *logrus.Logger
+
+	// sends tracks the messages in flight so shutdown can wait for them
+	sends sync.WaitGroup
+}
+
+func NewBackgroundMailer(mailer mail.Sender, log *logrus.Logger) *BackgroundMailer {
+	return &BackgroundMailer{
+		mailer: mailer,
+		log:    log,
+	}
+}
+
+func (m *BackgroundMailer) Send(message mail.Message) {
+	m.sends.Add(1)
+
+	go func() {
+		defer m.sends.Done()
+
+		if err := m.mailer.Send(message); err != nil {
+			m.log.Errorf("Error while sending the %q email: %s", message.Subject, err.Error())
+		}
+	}()
+}
+
+// Wait blocks until the messages in flight are sent or ctx is done.
+func (m *BackgroundMailer) Wait(ctx context.Context) error {
+	return waitGroupWait(ctx, &m.sends)
+}
+
+// waitGroupWait blocks until wg is done or ctx is done, whichever comes first.
+func waitGroupWait(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rtsoy/todo-app/pkg/mail"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type blockingSender struct {
+	release chan struct{}
+	err     error
+
+	mu   sync.Mutex
+	sent []mail.Message
+}
+
+func (s *blockingSender) Send(message mail.Message) error {
+	<-s.release
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, message)
+
+	return s.err
+}
+
+func TestBackgroundMailer_Wait(t *testing.T) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+
+	sender := &blockingSender{release: make(chan struct{}), err: errors.New("mail server is down")}
+	mailer := NewBackgroundMailer(sender, log)
+
+	mailer.Send(mail.Message{To: "test@example.com", Subject: "Reset your password"})
+
+	// The message is still in flight
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, mailer.Wait(ctx), context.DeadlineExceeded)
+
+	close(sender.release)
+
+	// A failed delivery is only logged
+	assert.NoError(t, mailer.Wait(context.Background()))
+	assert.Len(t, sender.sent, 1)
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	dataExportTTL = 7 * 24 * time.Hour
+	// Pending exports older than this were interrupted and no longer block a new one
+	dataExportStaleAfter = time.Hour
+
+	dataExportTokenPurpose = "export"
+)
+
+type dataExportList struct {
+	model.TodoList
+	Items []model.TodoItem `json:"items"`
+}
+
+type DataExportService struct {
+	repository     repository.DataExportRepository
+	userRepository repository.UserRepository
+	lists          repository.TodoListRepository
+	items          repository.TodoItemRepository
+	keys           KeyServicer
+	apiURL         string
+	log            *logrus.Logger
+
+	// builds tracks the exports being built so shutdown can wait for them
+	builds sync.WaitGroup
+}
+
+func NewDataExportService(repository repository.DataExportRepository, userRepository repository.UserRepository,
+	lists repository.TodoListRepository, items repository.TodoItemRepository, keys KeyServicer,
+	apiURL string, log *logrus.Logger) DataExportServicer {
+	return &DataExportService{
+		repository:     repository,
+		userRepository: userRepository,
+		lists:          lists,
+		items:          items,
+		keys:           keys,
+		apiURL:         apiURL,
+		log:            log,
+	}
+}
+
+// Create starts building an export in the background. Its status can be polled with Get.
+func (s *DataExportService) Create(userID uuid.UUID) (*model.DataExport, error) {
+	now := time.Now().UTC()
+
+	if err := s.repository.Cleanup(userID, now.Add(-dataExportStaleAfter), now); err != nil {
+		return nil, err
+	}
+
+	export := model.DataExport{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Status:    model.DataExportPending,
+		CreatedAt: now,
+	}
+
+	if err := s.repository.Create(export); err != nil {
+		if err.Error() == "pq: duplicate key value violates unique constraint \"data_exports_pending_user_id_key\"" {
+			return nil, errors.New("an export is already in progress")
+		}
+
+		return nil, err
+	}
+
+	s.builds.Add(1)
+	go s.build(export)
+
+	return &export, nil
+}
+
+// Wait blocks until the exports being built are done or ctx is done. Exports cut off by
+// shutdown stay pending and stop blocking new ones after dataExportStaleAfter.
+func (s *DataExportService) Wait(ctx context.Context) error {
+	return waitGroupWait(ctx, &s.builds)
+}
+
+// Get returns the export with a fresh download link once it is ready.
+func (s *DataExportService) Get(userID, exportID uuid.UUID) (*model.DataExport, error) {
+	export, err := s.repository.GetByID(userID, exportID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("export not found")
+		}
+
+		return nil, err
+	}
+
+	if export.Status != model.DataExportReady {
+		return export, nil
+	}
+
+	ttl := time.Until(*export.ExpiresAt)
+	if ttl <= 0 {
+		return nil, errors.New("export not found")
+	}
+
+	claims := model.RegisteredClaims{Subject: export.ID.String()}
+	token, err := s.keys.Sign(&claims, dataExportTokenPurpose, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	export.DownloadURL = fmt.Sprintf("%s/exports/download?token=%s", s.apiURL, url.QueryEscape(token))
+
+	return export, nil
+}
+
+// Download returns the archive the link was issued for. The link is the only credential.
+func (s *DataExportService) Download(token string) (*model.DataExport, error) {
+	var claims model.RegisteredClaims
+	if err := s.keys.Parse(token, dataExportTokenPurpose, &claims); err != nil {
+		return nil, errors.New("invalid or expired download link")
+	}
+
+	exportID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, errors.New("invalid or expired download link")
+	}
+
+	export, err := s.repository.GetArchive(exportID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("invalid or expired download link")
+		}
+
+		return nil, err
+	}
+
+	if export.Status != model.DataExportReady || time.Now().UTC().After(*export.ExpiresAt) {
+		return nil, errors.New("invalid or expired download link")
+	}
+
+	return export, nil
+}
+
+// build runs in its own goroutine, a failure is logged and recorded on the export.
+func (s *DataExportService) build(export model.DataExport) {
+	defer s.builds.Done()
+
+	defer func() {
+		if r := recover(); r != nil {
+			s.fail(export, fmt.Errorf("panic: %v", r))
+		}
+	}()
+
+	archive, err := s.buildArchive(export.UserID)
+	if err != nil {
+		s.fail(export, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	if err := s.repository.Complete(export.ID, archive, now, now.Add(dataExportTTL)); err != nil {
+		s.fail(export, err)
+	}
+}
+
+func (s *DataExportService) fail(export model.DataExport, err error) {
+	s.log.Errorf("Error while building the data export %s: %s", export.ID, err.Error())
+
+	if err := s.repository.Fail(export.ID, time.Now().UTC()); err != nil {
+		s.log.Errorf("Error while marking the data export %s as failed: %s", export.ID, err.Error())
+	}
+}
+
+// buildArchive collects the profile and every list with its items into a zip with
+// a JSON and a CSV representation.
+func (s *DataExportService) buildArchive(userID uuid.UUID) ([]byte, error) {
+	user, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	todoLists, err := s.lists.GetAll(userID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	lists := make([]dataExportList, 0, len(todoLists))
+	for _, list := range todoLists {
+		items, err := s.items.GetAll(userID, list.ID, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if items == nil {
+			items = []model.TodoItem{}
+		}
+
+		lists = append(lists, dataExportList{TodoList: list, Items: items})
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	if err := writeZipJSON(archive, "profile.json", user); err != nil {
+		return nil, err
+	}
+
+	if err := writeZipJSON(archive, "lists.json", lists); err != nil {
+		return nil, err
+	}
+
+	listRows := [][]string{{"id", "title", "description", "created_at"}}
+	itemRows := [][]string{{"id", "list_id", "title", "description", "created_at", "deadline", "completed"}}
+	for _, list := range lists {
+		listRows = append(listRows, []string{
+			list.ID.String(), list.Title, list.Description, list.CreatedAt.Format(time.RFC3339),
+		})
+
+		for _, item := range list.Items {
+			itemRows = append(itemRows, []string{
+				item.ID.String(), list.ID.String(), item.Title, item.Description, item.CreatedAt.Format(time.RFC3339),
+				item.Deadline.Format(time.RFC3339), strconv.FormatBool(item.Completed),
+			})
+		}
+	}
+
+	if err := writeZipCSV(archive, "lists.csv", listRows); err != nil {
+		return nil, err
+	}
+
+	if err := writeZipCSV(archive, "items.csv", itemRows); err != nil {
+		return nil, err
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeZipJSON(archive *zip.Writer, name string, v any) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(v)
+}
+
+func writeZipCSV(archive *zip.Writer, name string, rows [][]string) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	return csv.NewWriter(w).WriteAll(rows)
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+	"github.com/rtsoy/todo-app/pkg/mail"
+)
+
+const (
+	emailVerificationTokenTTL    = 24 * time.Hour
+	emailVerificationTokenLength = 32
+)
+
+// Policies for users whose email address is not verified yet, other values are rejected
+// when the config is loaded
+const (
+	verificationPolicyOff      = "off"      // no restrictions
+	verificationPolicyRestrict = "restrict" // read-only access to the API
+	verificationPolicyBlock    = "block"    // no access to the API
+)
+
+type EmailVerificationService struct {
+	repository       repository.EmailVerificationRepository
+	userRepository   repository.UserRepository
+	mailer           mail.Sender
+	backgroundMailer *BackgroundMailer
+	appURL           string
+	policy           string
+}
+
+func NewEmailVerificationService(repository repository.EmailVerificationRepository, userRepository repository.UserRepository,
+	mailer mail.Sender, backgroundMailer *BackgroundMailer, appURL, policy string) EmailVerificationServicer {
+	return &EmailVerificationService{
+		repository:       repository,
+		userRepository:   userRepository,
+		mailer:           mailer,
+		backgroundMailer: backgroundMailer,
+		appURL:           appURL,
+		policy:           policy,
+	}
+}
+
+// Send emails a verification link for the given address to the user.
+func (s *EmailVerificationService) Send(user model.User, email string) error {
+	message, err := s.newMessage(user, email)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(message)
+}
+
+// newMessage stores a new verification token for the address and returns the email with its link.
+func (s *EmailVerificationService) newMessage(user model.User, email string) (mail.Message, error) {
+	token, err := generateRandomToken(emailVerificationTokenLength)
+	if err != nil {
+		return mail.Message{}, err
+	}
+
+	now := time.Now().UTC()
+	verificationToken := model.EmailVerificationToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Email:     email,
+		TokenHash: hashToken(token),
+		CreatedAt: now,
+		ExpiresAt: now.Add(emailVerificationTokenTTL),
+	}
+
+	if err := s.repository.Create(verificationToken); err != nil {
+		return mail.Message{}, err
+	}
+
+	link := fmt.Sprintf("%s/auth/verify?token=%s", s.appURL, url.QueryEscape(token))
+
+	return mail.Message{
+		To:      email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm that %s is your email address by opening the link below. "+
+			"It expires in %s.\n\n%s\n", user.Username, email, emailVerificationTokenTTL, link),
+	}, nil
+}
+
+// Resend issues a new verification email. Like password resets, unknown or already
+// verified addresses are silently ignored and the email is sent in the background,
+// so the response does not tell unverified accounts apart.
+func (s *EmailVerificationService) Resend(input model.ResendVerificationDTO) error {
+	user, err := s.userRepository.GetByEmail(input.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	if user.VerifiedAt != nil {
+		return nil
+	}
+
+	message, err := s.newMessage(*user, user.Email)
+	if err != nil {
+		return err
+	}
+
+	s.backgroundMailer.Send(message)
+
+	return nil
+}
+
+func (s *EmailVerificationService) Verify(token string) error {
+	verificationToken, err := s.repository.GetByTokenHash(hashToken(token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("invalid or expired verification token")
+		}
+
+		return err
+	}
+
+	if verificationToken.UsedAt != nil || time.Now().UTC().After(verificationToken.ExpiresAt) {
+		return errors.New("invalid or expired verification token")
+	}
+
+	if err := s.repository.Use(verificationToken.ID, verificationToken.UserID, verificationToken.Email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("invalid or expired verification token")
+		}
+
+		if err.Error() == "pq: duplicate key value violates unique constraint \"users_email_key\"" {
+			return errors.New("email is already taken")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// CheckAccess applies the configured verification policy to a request of the user.
+func (s *EmailVerificationService) CheckAccess(userID uuid.UUID, write bool) error {
+	switch s.policy {
+	case verificationPolicyBlock:
+	case verificationPolicyRestrict:
+		if !write {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	user, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		return err
+	}
+
+	if user.VerifiedAt == nil {
+		return errors.New("email address is not verified")
+	}
+
+	return nil
+}
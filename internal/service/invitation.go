@@ -0,0 +1,123 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+const (
+	invitationCodeLength = 16
+	maxInvitationUses    = 1000
+)
+
+// RegistrationPolicy decides who may create an account. AllowedDomains is only used in
+// the domains mode.
+type RegistrationPolicy struct {
+	Mode           string
+	AllowedDomains []string
+}
+
+// Check reports whether an account may be created for the email address. Invitation
+// codes are checked when the account is stored, invited only tells a code was given.
+func (p RegistrationPolicy) Check(email string, invited bool) error {
+	switch p.Mode {
+	case model.RegistrationOpen, "":
+		return nil
+	case model.RegistrationInvite:
+		if !invited {
+			return errors.New("an invitation code is required")
+		}
+
+		return nil
+	case model.RegistrationDomains:
+		_, domain, _ := strings.Cut(email, "@")
+		for _, allowed := range p.AllowedDomains {
+			if strings.EqualFold(strings.TrimSpace(allowed), domain) {
+				return nil
+			}
+		}
+
+		return errors.New("registration is limited to allowed email domains")
+	default:
+		// Unknown modes close registration rather than open it by accident
+		return errors.New("registration is disabled")
+	}
+}
+
+type InvitationService struct {
+	repository repository.InvitationRepository
+}
+
+func NewInvitationService(repository repository.InvitationRepository) InvitationServicer {
+	return &InvitationService{
+		repository: repository,
+	}
+}
+
+func (s *InvitationService) Create(createdBy uuid.UUID, input model.CreateInvitationDTO) (model.CreatedInvitation, error) {
+	if input.MaxUses == 0 {
+		input.MaxUses = 1
+	}
+
+	if input.MaxUses < 0 || input.MaxUses > maxInvitationUses {
+		return model.CreatedInvitation{}, errors.New("max uses must be between 1 and 1000")
+	}
+
+	input.ExpiresAt = toUTC(input.ExpiresAt)
+
+	now := time.Now().UTC()
+	if input.ExpiresAt != nil && now.After(*input.ExpiresAt) {
+		return model.CreatedInvitation{}, errors.New("expiration cannot be in the past")
+	}
+
+	code, err := generateRandomToken(invitationCodeLength)
+	if err != nil {
+		return model.CreatedInvitation{}, err
+	}
+
+	invitation := model.Invitation{
+		ID:        uuid.New(),
+		CodeHash:  hashToken(code),
+		CreatedBy: &createdBy,
+		MaxUses:   input.MaxUses,
+		CreatedAt: now,
+		ExpiresAt: input.ExpiresAt,
+	}
+
+	if err := s.repository.Create(invitation); err != nil {
+		return model.CreatedInvitation{}, err
+	}
+
+	return model.CreatedInvitation{Invitation: invitation, Code: code}, nil
+}
+
+func (s *InvitationService) GetAll() ([]model.Invitation, error) {
+	invitations, err := s.repository.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if invitations == nil {
+		invitations = []model.Invitation{}
+	}
+
+	return invitations, nil
+}
+
+func (s *InvitationService) Revoke(invitationID uuid.UUID) error {
+	if err := s.repository.Revoke(invitationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("invitation not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"crypto"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+	"github.com/rtsoy/todo-app/pkg/jwk"
+)
+
+const (
+	keyIDLength = 12
+
+	// How often an instance picks up keys rotated by other instances
+	keyReloadInterval = time.Minute
+	// Lower bound between reloads triggered by tokens with an unknown kid
+	keyReloadMinInterval = 10 * time.Second
+)
+
+type signingKey struct {
+	id         string
+	algorithm  string
+	privateKey crypto.Signer
+	createdAt  time.Time
+}
+
+// KeyService signs and verifies every token the application issues. The newest key
+// signs; retired keys keep verifying until their verification period is over.
+type KeyService struct {
+	repository         repository.SigningKeyRepository
+	algorithm          string
+	rotationInterval   time.Duration
+	verificationPeriod time.Duration
+	issuer             string
+	audience           string
+	clockSkew          time.Duration
+
+	mu       sync.RWMutex
+	current  *signingKey
+	keys     []*signingKey
+	loadedAt time.Time
+}
+
+func NewKeyService(repository repository.SigningKeyRepository, algorithm string,
+	rotationInterval, verificationPeriod time.Duration, issuer, audience string, clockSkew time.Duration) KeyServicer {
+	return &KeyService{
+		repository:         repository,
+		algorithm:          algorithm,
+		rotationInterval:   rotationInterval,
+		verificationPeriod: verificationPeriod,
+		issuer:             issuer,
+		audience:           audience,
+		clockSkew:          clockSkew,
+	}
+}
+
+// Load reads the keys and rotates the signing key when there is none yet, when it is
+// older than the rotation interval or when the configured algorithm changed.
+func (s *KeyService) Load() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.load()
+}
+
+// Sign fills in the registered claims and signs the token. The purpose becomes part of
+// the audience, so a token issued for one purpose is never accepted for another.
+// Access tokens have no purpose and carry the plain audience other services check.
+func (s *KeyService) Sign(claims model.Claims, purpose string, ttl time.Duration) (string, error) {
+	key, err := s.signingKey()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	registered := claims.Registered()
+	registered.Issuer = s.issuer
+	registered.Audience = s.audienceFor(purpose)
+	registered.IssuedAt = now.Unix()
+	registered.NotBefore = now.Unix()
+	registered.ExpiresAt = now.Add(ttl).Unix()
+	registered.ID = uuid.NewString()
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.algorithm), claims)
+	token.Header["kid"] = key.id
+
+	return token.SignedString(key.privateKey)
+}
+
+// Parse verifies the signature and the registered claims and decodes the token into claims.
+func (s *KeyService) Parse(tokenString, purpose string, claims model.Claims) error {
+	parser := &jwt.Parser{SkipClaimsValidation: true}
+
+	if _, err := parser.ParseWithClaims(tokenString, claims, s.keyFunc); err != nil {
+		var validationErr *jwt.ValidationError
+		if !errors.As(err, &validationErr) {
+			return err
+		}
+
+		switch {
+		case validationErr.Errors&jwt.ValidationErrorMalformed != 0:
+			return errors.New("token is malformed")
+		case validationErr.Errors&jwt.ValidationErrorUnverifiable != 0 && validationErr.Inner != nil:
+			return validationErr.Inner
+		case validationErr.Errors&jwt.ValidationErrorSignatureInvalid != 0:
+			return errors.New("token signature is invalid")
+		default:
+			return errors.New("invalid token")
+		}
+	}
+
+	return claims.Registered().Validate(s.issuer, s.audienceFor(purpose), time.Now(), s.clockSkew)
+}
+
+// JWKS returns the public halves of all keys that may still verify tokens.
+func (s *KeyService) JWKS() (jwk.Set, error) {
+	if _, err := s.signingKey(); err != nil {
+		return jwk.Set{}, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	set := jwk.Set{Keys: make([]jwk.Key, 0, len(s.keys))}
+	for _, key := range s.keys {
+		webKey, err := jwk.New(key.id, key.algorithm, key.privateKey.Public())
+		if err != nil {
+			return jwk.Set{}, err
+		}
+
+		set.Keys = append(set.Keys, webKey)
+	}
+
+	return set, nil
+}
+
+// signingKey returns the current key, reloading and rotating first when that is due.
+func (s *KeyService) signingKey() (*signingKey, error) {
+	s.mu.RLock()
+	key, due := s.current, s.due()
+	s.mu.RUnlock()
+
+	if !due {
+		return key, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.due() {
+		return s.current, nil
+	}
+
+	// Keep signing with the cached key if the database is unavailable for a moment
+	if err := s.load(); err != nil && s.current == nil {
+		return nil, err
+	}
+
+	return s.current, nil
+}
+
+func (s *KeyService) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	s.mu.RLock()
+	key := s.lookup(kid)
+	reload := key == nil && time.Since(s.loadedAt) > keyReloadMinInterval
+	s.mu.RUnlock()
+
+	if reload {
+		s.mu.Lock()
+		if err := s.reload(); err != nil {
+			s.mu.Unlock()
+			return nil, err
+		}
+		key = s.lookup(kid)
+		s.mu.Unlock()
+	}
+
+	if key == nil {
+		return nil, fmt.Errorf("unknown signing key: %q", kid)
+	}
+
+	if token.Method.Alg() != key.algorithm {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	return key.privateKey.Public(), nil
+}
+
+func (s *KeyService) audienceFor(purpose string) string {
+	if purpose == "" {
+		return s.audience
+	}
+
+	return s.audience + "/" + purpose
+}
+
+// The methods below must be called with mu held.
+
+func (s *KeyService) due() bool {
+	return s.current == nil ||
+		time.Since(s.loadedAt) > keyReloadInterval ||
+		time.Since(s.current.createdAt) > s.rotationInterval
+}
+
+func (s *KeyService) lookup(kid string) *signingKey {
+	for _, key := range s.keys {
+		if key.id == kid {
+			return key
+		}
+	}
+
+	return nil
+}
+
+func (s *KeyService) load() error {
+	if err := s.reload(); err != nil {
+		return err
+	}
+
+	if s.current != nil && s.current.algorithm == s.algorithm &&
+		time.Since(s.current.createdAt) < s.rotationInterval {
+		return nil
+	}
+
+	// sql.ErrNoRows means another instance rotated first, its key is picked up below
+	if err := s.rotate(); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return s.reload()
+}
+
+func (s *KeyService) reload() error {
+	stored, err := s.repository.GetAllValid()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]*signingKey, 0, len(stored))
+	var current *signingKey
+
+	for _, storedKey := range stored {
+		privateKey, err := jwk.ParsePrivateKey(storedKey.PrivateKey)
+		if err != nil {
+			return fmt.Errorf("signing key %s: %w", storedKey.ID, err)
+		}
+
+		key := &signingKey{
+			id:         storedKey.ID,
+			algorithm:  storedKey.Algorithm,
+			privateKey: privateKey,
+			createdAt:  storedKey.CreatedAt,
+		}
+		keys = append(keys, key)
+
+		// Keys come newest first
+		if current == nil && storedKey.RetiredAt == nil {
+			current = key
+		}
+	}
+
+	s.keys = keys
+	s.current = current
+	s.loadedAt = time.Now()
+
+	return nil
+}
+
+func (s *KeyService) rotate() error {
+	privateKey, err := jwk.GenerateKey(s.algorithm)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := jwk.MarshalPrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+
+	id, err := generateRandomToken(keyIDLength)
+	if err != nil {
+		return err
+	}
+
+	var currentID string
+	if s.current != nil {
+		currentID = s.current.id
+	}
+
+	now := time.Now().UTC()
+	key := model.SigningKey{
+		ID:         id,
+		Algorithm:  s.algorithm,
+		PrivateKey: encoded,
+		CreatedAt:  now,
+	}
+
+	return s.repository.Rotate(key, currentID, now.Add(s.verificationPeriod))
+}
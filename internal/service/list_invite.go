@@ -0,0 +1,161 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+const (
+	listInviteTokenLength = 24
+	maxListInviteUses     = 1000
+)
+
+type ListInviteService struct {
+	repository     repository.ListInviteRepository
+	listRepository repository.TodoListRepository
+	appURL         string
+}
+
+func NewListInviteService(repository repository.ListInviteRepository, listRepository repository.TodoListRepository,
+	appURL string) ListInviteServicer {
+	return &ListInviteService{
+		repository:     repository,
+		listRepository: listRepository,
+		appURL:         appURL,
+	}
+}
+
+// Create makes an invite link for the list. Only the owner can invite people.
+func (s *ListInviteService) Create(userID, listID uuid.UUID, input model.CreateListInviteDTO) (model.CreatedListInvite, error) {
+	if input.Role == "" {
+		input.Role = model.ListRoleEditor
+	}
+
+	if err := validateMemberRole(input.Role); err != nil {
+		return model.CreatedListInvite{}, err
+	}
+
+	if input.MaxUses == 0 {
+		input.MaxUses = 1
+	}
+
+	if input.MaxUses < 0 || input.MaxUses > maxListInviteUses {
+		return model.CreatedListInvite{}, errors.New("max uses must be between 1 and 1000")
+	}
+
+	input.ExpiresAt = toUTC(input.ExpiresAt)
+
+	now := time.Now().UTC()
+	if input.ExpiresAt != nil && now.After(*input.ExpiresAt) {
+		return model.CreatedListInvite{}, errors.New("expiration cannot be in the past")
+	}
+
+	if err := s.checkOwner(userID, listID); err != nil {
+		return model.CreatedListInvite{}, err
+	}
+
+	token, err := generateRandomToken(listInviteTokenLength)
+	if err != nil {
+		return model.CreatedListInvite{}, err
+	}
+
+	invite := model.ListInvite{
+		ID:        uuid.New(),
+		ListID:    listID,
+		TokenHash: hashToken(token),
+		Role:      input.Role,
+		CreatedBy: &userID,
+		MaxUses:   input.MaxUses,
+		CreatedAt: now,
+		ExpiresAt: input.ExpiresAt,
+	}
+
+	if err := s.repository.Create(invite); err != nil {
+		return model.CreatedListInvite{}, err
+	}
+
+	return model.CreatedListInvite{
+		ListInvite: invite,
+		Token:      token,
+		Link:       fmt.Sprintf("%s/invites/%s", s.appURL, token),
+	}, nil
+}
+
+// GetAll returns the invites of the list that can still be accepted.
+func (s *ListInviteService) GetAll(userID, listID uuid.UUID) ([]model.ListInvite, error) {
+	if err := s.checkOwner(userID, listID); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.repository.GetAll(listID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if invites == nil {
+		invites = []model.ListInvite{}
+	}
+
+	return invites, nil
+}
+
+func (s *ListInviteService) Revoke(userID, listID, inviteID uuid.UUID) error {
+	if err := s.checkOwner(userID, listID); err != nil {
+		return err
+	}
+
+	if err := s.repository.Revoke(listID, inviteID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("invite not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Accept adds the user to the list of the invite and returns the list.
+func (s *ListInviteService) Accept(userID uuid.UUID, token string) (model.TodoList, error) {
+	listID, err := s.repository.Accept(hashToken(token), userID, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.TodoList{}, errors.New("invalid or expired invite")
+		}
+
+		if err.Error() == "pq: duplicate key value violates unique constraint \"users_lists_user_id_list_id_key\"" {
+			return model.TodoList{}, errors.New("you are already a member of this list")
+		}
+
+		if errors.Is(err, repository.ErrQuotaExceeded) {
+			return model.TodoList{}, errors.New("exceeded the maximum allowed number of members in the list")
+		}
+
+		return model.TodoList{}, err
+	}
+
+	return s.listRepository.GetByID(userID, listID)
+}
+
+func (s *ListInviteService) checkOwner(userID, listID uuid.UUID) error {
+	role, err := s.listRepository.GetRole(userID, listID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("todo list not found")
+		}
+
+		return err
+	}
+
+	if role != model.ListRoleOwner {
+		return errors.New("only the owner can manage invites")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	mock_repository "github.com/rtsoy/todo-app/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListInviteService_Permissions(t *testing.T) {
+	type mockBehavior func(invites *mock_repository.MockListInviteRepository, userID, listID uuid.UUID)
+
+	tests := []struct {
+		name         string
+		mockBehavior mockBehavior
+		call         func(s *ListInviteService, userID, listID uuid.UUID) error
+	}{
+		{
+			name: "Create",
+			mockBehavior: func(invites *mock_repository.MockListInviteRepository, userID, listID uuid.UUID) {
+				invites.EXPECT().Create(gomock.Any()).Return(nil)
+			},
+			call: func(s *ListInviteService, userID, listID uuid.UUID) error {
+				_, err := s.Create(userID, listID, model.CreateListInviteDTO{})
+				return err
+			},
+		},
+		{
+			name: "Get All",
+			mockBehavior: func(invites *mock_repository.MockListInviteRepository, userID, listID uuid.UUID) {
+				invites.EXPECT().GetAll(listID, gomock.Any()).Return(nil, nil)
+			},
+			call: func(s *ListInviteService, userID, listID uuid.UUID) error {
+				_, err := s.GetAll(userID, listID)
+				return err
+			},
+		},
+		{
+			name: "Revoke",
+			mockBehavior: func(invites *mock_repository.MockListInviteRepository, userID, listID uuid.UUID) {
+				invites.EXPECT().Revoke(listID, uuid.Nil).Return(nil)
+			},
+			call: func(s *ListInviteService, userID, listID uuid.UUID) error {
+				return s.Revoke(userID, listID, uuid.Nil)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for _, role := range listRoles {
+			test, role := test, role
+
+			t.Run(test.name+" As "+role, func(t *testing.T) {
+				c := gomock.NewController(t)
+				defer c.Finish()
+				userID := uuid.New()
+				listID := uuid.New()
+
+				invites := mock_repository.NewMockListInviteRepository(c)
+				lists := mock_repository.NewMockTodoListRepository(c)
+				lists.EXPECT().GetRole(userID, listID).Return(role, nil)
+				if role == model.ListRoleOwner {
+					test.mockBehavior(invites, userID, listID)
+				}
+
+				s := &ListInviteService{repository: invites, listRepository: lists}
+
+				err := test.call(s, userID, listID)
+				if role == model.ListRoleOwner {
+					assert.NoError(t, err)
+					return
+				}
+
+				assert.EqualError(t, err, "only the owner can manage invites")
+			})
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+const listTransferTTL = 7 * 24 * time.Hour
+
+type ListTransferService struct {
+	repository     repository.ListTransferRepository
+	listRepository repository.TodoListRepository
+	userRepository repository.UserRepository
+}
+
+func NewListTransferService(repository repository.ListTransferRepository, listRepository repository.TodoListRepository,
+	userRepository repository.UserRepository) ListTransferServicer {
+	return &ListTransferService{
+		repository:     repository,
+		listRepository: listRepository,
+		userRepository: userRepository,
+	}
+}
+
+// Create offers the list to the user found by username or email. The list only changes hands
+// once they accept, until then the owner can cancel or send a new offer to someone else.
+func (s *ListTransferService) Create(userID, listID uuid.UUID, input model.CreateListTransferDTO) (model.ListTransfer, error) {
+	list, err := s.listRepository.GetByID(userID, listID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ListTransfer{}, errors.New("todo list not found")
+		}
+
+		return model.ListTransfer{}, err
+	}
+
+	if list.Role != model.ListRoleOwner {
+		return model.ListTransfer{}, errors.New("only the owner can transfer the list")
+	}
+
+	owner, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		return model.ListTransfer{}, err
+	}
+
+	recipient, err := s.userRepository.GetByLogin(normalizeLogin(input.Login))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ListTransfer{}, errors.New("user not found")
+		}
+
+		return model.ListTransfer{}, err
+	}
+
+	if recipient.ID == userID {
+		return model.ListTransfer{}, errors.New("you already own this list")
+	}
+
+	now := time.Now().UTC()
+
+	transfer := model.ListTransfer{
+		ID:           uuid.New(),
+		ListID:       listID,
+		ListTitle:    list.Title,
+		FromUserID:   userID,
+		FromUsername: owner.Username,
+		ToUserID:     recipient.ID,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(listTransferTTL),
+	}
+
+	if err := s.repository.Create(transfer); err != nil {
+		return model.ListTransfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// GetIncoming returns the transfers the user can accept.
+func (s *ListTransferService) GetIncoming(userID uuid.UUID) ([]model.ListTransfer, error) {
+	transfers, err := s.repository.GetIncoming(userID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if transfers == nil {
+		transfers = []model.ListTransfer{}
+	}
+
+	return transfers, nil
+}
+
+func (s *ListTransferService) Cancel(userID, listID uuid.UUID) error {
+	role, err := s.listRepository.GetRole(userID, listID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("todo list not found")
+		}
+
+		return err
+	}
+
+	if role != model.ListRoleOwner {
+		return errors.New("only the owner can transfer the list")
+	}
+
+	if err := s.repository.Cancel(listID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("transfer not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Accept makes the user the owner of the list. Unless archived the list counts towards the quota
+// of the user's plan from now on, the previous owner keeps access as an editor and counts as a member.
+func (s *ListTransferService) Accept(userID, transferID uuid.UUID) (model.TodoList, error) {
+	listID, err := s.repository.Accept(transferID, userID, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.TodoList{}, errors.New("transfer not found")
+		}
+
+		if errors.Is(err, repository.ErrMemberQuotaExceeded) {
+			return model.TodoList{}, errors.New("exceeded the maximum allowed number of members in the list")
+		}
+
+		if errors.Is(err, repository.ErrQuotaExceeded) {
+			return model.TodoList{}, errors.New("exceeded the maximum allowed limit of existing lists")
+		}
+
+		return model.TodoList{}, err
+	}
+
+	return s.listRepository.GetByID(userID, listID)
+}
+
+func (s *ListTransferService) Decline(userID, transferID uuid.UUID) error {
+	if err := s.repository.Decline(transferID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("transfer not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	mock_repository "github.com/rtsoy/todo-app/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTransferService_Permissions(t *testing.T) {
+	type mockBehavior func(transfers *mock_repository.MockListTransferRepository,
+		lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+		userID, listID uuid.UUID, role string)
+
+	recipient := &model.User{ID: uuid.New(), Username: "recipient", Email: "recipient@example.com"}
+
+	tests := []struct {
+		name         string
+		mockBehavior mockBehavior
+		call         func(s *ListTransferService, userID, listID uuid.UUID) error
+	}{
+		{
+			name: "Create",
+			mockBehavior: func(transfers *mock_repository.MockListTransferRepository,
+				lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string) {
+				lists.EXPECT().GetByID(userID, listID).Return(model.TodoList{ID: listID, Role: role}, nil)
+				if role == model.ListRoleOwner {
+					users.EXPECT().GetByID(userID).Return(&model.User{ID: userID, Username: "owner"}, nil)
+					users.EXPECT().GetByLogin("recipient").Return(recipient, nil)
+					transfers.EXPECT().Create(gomock.Any()).Return(nil)
+				}
+			},
+			call: func(s *ListTransferService, userID, listID uuid.UUID) error {
+				_, err := s.Create(userID, listID, model.CreateListTransferDTO{Login: "recipient"})
+				return err
+			},
+		},
+		{
+			name: "Cancel",
+			mockBehavior: func(transfers *mock_repository.MockListTransferRepository,
+				lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string) {
+				lists.EXPECT().GetRole(userID, listID).Return(role, nil)
+				if role == model.ListRoleOwner {
+					transfers.EXPECT().Cancel(listID).Return(nil)
+				}
+			},
+			call: func(s *ListTransferService, userID, listID uuid.UUID) error {
+				return s.Cancel(userID, listID)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for _, role := range listRoles {
+			test, role := test, role
+
+			t.Run(test.name+" As "+role, func(t *testing.T) {
+				c := gomock.NewController(t)
+				defer c.Finish()
+				userID := uuid.New()
+				listID := uuid.New()
+
+				transfers := mock_repository.NewMockListTransferRepository(c)
+				lists := mock_repository.NewMockTodoListRepository(c)
+				users := mock_repository.NewMockUserRepository(c)
+				test.mockBehavior(transfers, lists, users, userID, listID, role)
+
+				s := &ListTransferService{repository: transfers, listRepository: lists, userRepository: users}
+
+				err := test.call(s, userID, listID)
+				if role == model.ListRoleOwner {
+					assert.NoError(t, err)
+					return
+				}
+
+				assert.EqualError(t, err, "only the owner can transfer the list")
+			})
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+const (
+	loginAttemptAccountPrefix = "account:"
+	loginAttemptIPPrefix      = "ip:"
+)
+
+// LoginAttemptPolicy decides when sign-ins get locked. Once an account or an IP address
+// reaches its failure limit every further failure locks it for twice as long as the
+// previous one, starting at LockoutBase and capped at LockoutMax.
+type LoginAttemptPolicy struct {
+	MaxAccountFailures int
+	MaxIPFailures      int
+	FailureWindow      time.Duration
+	LockoutBase        time.Duration
+	LockoutMax         time.Duration
+}
+
+type LoginAttemptService struct {
+	repository repository.LoginAttemptRepository
+	policy     LoginAttemptPolicy
+}
+
+func NewLoginAttemptService(repository repository.LoginAttemptRepository, policy LoginAttemptPolicy) LoginAttemptServicer {
+	return &LoginAttemptService{
+		repository: repository,
+		policy:     policy,
+	}
+}
+
+// Check returns a *model.LockedOutError while the account or the address is locked. It runs
+// before the password is checked, so locked requests never reach bcrypt.
+func (s *LoginAttemptService) Check(email, ip string) error {
+	now := time.Now().UTC()
+	var retryAfter time.Duration
+
+	for _, key := range loginAttemptKeys(email, ip) {
+		attempts, err := s.repository.Get(key)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+
+			return err
+		}
+
+		if attempts.LockedUntil != nil && attempts.LockedUntil.Sub(now) > retryAfter {
+			retryAfter = attempts.LockedUntil.Sub(now)
+		}
+	}
+
+	if retryAfter > 0 {
+		return &model.LockedOutError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+func (s *LoginAttemptService) RegisterFailure(email, ip string) error {
+	now := time.Now().UTC()
+
+	for _, key := range loginAttemptKeys(email, ip) {
+		failures, err := s.repository.RegisterFailure(key, now, s.policy.FailureWindow)
+		if err != nil {
+			return err
+		}
+
+		limit := s.policy.MaxAccountFailures
+		if strings.HasPrefix(key, loginAttemptIPPrefix) {
+			limit = s.policy.MaxIPFailures
+		}
+
+		if failures < limit {
+			continue
+		}
+
+		if err := s.repository.Lock(key, now.Add(s.lockoutFor(failures-limit))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RegisterSuccess clears the account counter. The address counter is left to expire,
+// otherwise signing in to an own account would reset it between guesses at others.
+func (s *LoginAttemptService) RegisterSuccess(email string) error {
+	err := s.repository.Delete(loginAttemptAccountPrefix + normalizeLogin(email))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *LoginAttemptService) Unlock(input model.UnlockSignInDTO) error {
+	keys := loginAttemptKeys(input.Email, input.IP)
+	if len(keys) == 0 {
+		return errors.New("email or ip is required")
+	}
+
+	unlocked := false
+	for _, key := range keys {
+		err := s.repository.Delete(key)
+		if err == nil {
+			unlocked = true
+			continue
+		}
+
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	if !unlocked {
+		return errors.New("no failed sign-ins recorded")
+	}
+
+	return nil
+}
+
+func (s *LoginAttemptService) lockoutFor(excess int) time.Duration {
+	lockout := s.policy.LockoutBase
+	for i := 0; i < excess && lockout < s.policy.LockoutMax; i++ {
+		lockout *= 2
+	}
+
+	if lockout > s.policy.LockoutMax {
+		return s.policy.LockoutMax
+	}
+
+	return lockout
+}
+
+func loginAttemptKeys(email, ip string) []string {
+	var keys []string
+
+	if email = normalizeLogin(email); email != "" {
+		keys = append(keys, loginAttemptAccountPrefix+email)
+	}
+
+	if ip = strings.TrimSpace(ip); ip != "" {
+		keys = append(keys, loginAttemptIPPrefix+ip)
+	}
+
+	return keys
+}
+
+// normalizeLogin brings an email address or a username to the form it is stored in.
+func normalizeLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
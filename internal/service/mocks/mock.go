@@ -5,11 +5,13 @@
 package mock_service
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
-	jwt "github.com/golang-jwt/jwt"
 	uuid "github.com/google/uuid"
 	model "github.com/rtsoy/todo-app/internal/model"
+	jwk "github.com/rtsoy/todo-app/pkg/jwk"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -132,6 +134,35 @@ func (m *MockTodoListServicer) EXPECT() *MockTodoListServicerMockRecorder {
 	return m.recorder
 }
 
+// AddMember mocks base method.
+func (m *MockTodoListServicer) AddMember(userID, listID uuid.UUID, input model.AddListMemberDTO) (model.ListMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", userID, listID, input)
+	ret0, _ := ret[0].(model.ListMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockTodoListServicerMockRecorder) AddMember(userID, listID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockTodoListServicer)(nil).AddMember), userID, listID, input)
+}
+
+// Archive mocks base method.
+func (m *MockTodoListServicer) Archive(userID, listID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", userID, listID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockTodoListServicerMockRecorder) Archive(userID, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockTodoListServicer)(nil).Archive), userID, listID)
+}
+
 // Create mocks base method.
 func (m *MockTodoListServicer) Create(userID uuid.UUID, list model.CreateTodoListDTO) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -162,18 +193,18 @@ func (mr *MockTodoListServicerMockRecorder) Delete(userID, listID interface{}) *
 }
 
 // GetAll mocks base method.
-func (m *MockTodoListServicer) GetAll(userID uuid.UUID, orderBy *string) ([]model.TodoList, error) {
+func (m *MockTodoListServicer) GetAll(userID uuid.UUID, archived bool, orderBy *string) ([]model.TodoList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", userID, orderBy)
+	ret := m.ctrl.Call(m, "GetAll", userID, archived, orderBy)
 	ret0, _ := ret[0].([]model.TodoList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockTodoListServicerMockRecorder) GetAll(userID, orderBy interface{}) *gomock.Call {
+func (mr *MockTodoListServicerMockRecorder) GetAll(userID, archived, orderBy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTodoListServicer)(nil).GetAll), userID, orderBy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTodoListServicer)(nil).GetAll), userID, archived, orderBy)
 }
 
 // GetByID mocks base method.
@@ -191,6 +222,49 @@ func (mr *MockTodoListServicerMockRecorder) GetByID(userID, listID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTodoListServicer)(nil).GetByID), userID, listID)
 }
 
+// GetMembers mocks base method.
+func (m *MockTodoListServicer) GetMembers(userID, listID uuid.UUID) ([]model.ListMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", userID, listID)
+	ret0, _ := ret[0].([]model.ListMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockTodoListServicerMockRecorder) GetMembers(userID, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockTodoListServicer)(nil).GetMembers), userID, listID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockTodoListServicer) RemoveMember(userID, listID, memberID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", userID, listID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockTodoListServicerMockRecorder) RemoveMember(userID, listID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockTodoListServicer)(nil).RemoveMember), userID, listID, memberID)
+}
+
+// Unarchive mocks base method.
+func (m *MockTodoListServicer) Unarchive(userID, listID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", userID, listID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockTodoListServicerMockRecorder) Unarchive(userID, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockTodoListServicer)(nil).Unarchive), userID, listID)
+}
+
 // Update mocks base method.
 func (m *MockTodoListServicer) Update(userID, listID uuid.UUID, data model.UpdateTodoListDTO) error {
 	m.ctrl.T.Helper()
@@ -205,6 +279,101 @@ func (mr *MockTodoListServicerMockRecorder) Update(userID, listID, data interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoListServicer)(nil).Update), userID, listID, data)
 }
 
+// UpdateMember mocks base method.
+func (m *MockTodoListServicer) UpdateMember(userID, listID, memberID uuid.UUID, input model.UpdateListMemberDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMember", userID, listID, memberID, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMember indicates an expected call of UpdateMember.
+func (mr *MockTodoListServicerMockRecorder) UpdateMember(userID, listID, memberID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockTodoListServicer)(nil).UpdateMember), userID, listID, memberID, input)
+}
+
+// MockKeyServicer is a mock of KeyServicer interface.
+type MockKeyServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyServicerMockRecorder
+}
+
+// MockKeyServicerMockRecorder is the mock recorder for MockKeyServicer.
+type MockKeyServicerMockRecorder struct {
+	mock *MockKeyServicer
+}
+
+// NewMockKeyServicer creates a new mock instance.
+func NewMockKeyServicer(ctrl *gomock.Controller) *MockKeyServicer {
+	mock := &MockKeyServicer{ctrl: ctrl}
+	mock.recorder = &MockKeyServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyServicer) EXPECT() *MockKeyServicerMockRecorder {
+	return m.recorder
+}
+
+// JWKS mocks base method.
+func (m *MockKeyServicer) JWKS() (jwk.Set, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(jwk.Set)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockKeyServicerMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockKeyServicer)(nil).JWKS))
+}
+
+// Load mocks base method.
+func (m *MockKeyServicer) Load() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockKeyServicerMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockKeyServicer)(nil).Load))
+}
+
+// Parse mocks base method.
+func (m *MockKeyServicer) Parse(token, purpose string, claims model.Claims) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parse", token, purpose, claims)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockKeyServicerMockRecorder) Parse(token, purpose, claims interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockKeyServicer)(nil).Parse), token, purpose, claims)
+}
+
+// Sign mocks base method.
+func (m *MockKeyServicer) Sign(claims model.Claims, purpose string, ttl time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", claims, purpose, ttl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockKeyServicerMockRecorder) Sign(claims, purpose, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockKeyServicer)(nil).Sign), claims, purpose, ttl)
+}
+
 // MockUserServicer is a mock of UserServicer interface.
 type MockUserServicer struct {
 	ctrl     *gomock.Controller
@@ -228,6 +397,20 @@ func (m *MockUserServicer) EXPECT() *MockUserServicerMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockUserServicer) ChangePassword(userID, sessionID uuid.UUID, input model.ChangePasswordDTO, metadata model.SessionMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", userID, sessionID, input, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserServicerMockRecorder) ChangePassword(userID, sessionID, input, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserServicer)(nil).ChangePassword), userID, sessionID, input, metadata)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserServicer) CreateUser(user model.CreateUserDTO) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -243,26 +426,55 @@ func (mr *MockUserServicerMockRecorder) CreateUser(user interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserServicer)(nil).CreateUser), user)
 }
 
+// Delete mocks base method.
+func (m *MockUserServicer) Delete(userID, sessionID uuid.UUID, input model.DeleteUserDTO, metadata model.SessionMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", userID, sessionID, input, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserServicerMockRecorder) Delete(userID, sessionID, input, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserServicer)(nil).Delete), userID, sessionID, input, metadata)
+}
+
 // GenerateToken mocks base method.
-func (m *MockUserServicer) GenerateToken(email, password string) (string, error) {
+func (m *MockUserServicer) GenerateToken(login, password string, metadata model.SessionMetadata) (model.Tokens, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateToken", email, password)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GenerateToken", login, password, metadata)
+	ret0, _ := ret[0].(model.Tokens)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateToken indicates an expected call of GenerateToken.
-func (mr *MockUserServicerMockRecorder) GenerateToken(email, password interface{}) *gomock.Call {
+func (mr *MockUserServicerMockRecorder) GenerateToken(login, password, metadata interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockUserServicer)(nil).GenerateToken), email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockUserServicer)(nil).GenerateToken), login, password, metadata)
+}
+
+// GetByID mocks base method.
+func (m *MockUserServicer) GetByID(userID uuid.UUID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUserServicerMockRecorder) GetByID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserServicer)(nil).GetByID), userID)
 }
 
 // ParseToken mocks base method.
-func (m *MockUserServicer) ParseToken(accessToken string) (jwt.MapClaims, error) {
+func (m *MockUserServicer) ParseToken(accessToken string) (*model.AccessTokenClaims, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ParseToken", accessToken)
-	ret0, _ := ret[0].(jwt.MapClaims)
+	ret0, _ := ret[0].(*model.AccessTokenClaims)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -272,3 +484,1247 @@ func (mr *MockUserServicerMockRecorder) ParseToken(accessToken interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockUserServicer)(nil).ParseToken), accessToken)
 }
+
+// Update mocks base method.
+func (m *MockUserServicer) Update(userID uuid.UUID, input model.UpdateUserDTO) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", userID, input)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserServicerMockRecorder) Update(userID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserServicer)(nil).Update), userID, input)
+}
+
+// MockSessionServicer is a mock of SessionServicer interface.
+type MockSessionServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionServicerMockRecorder
+}
+
+// MockSessionServicerMockRecorder is the mock recorder for MockSessionServicer.
+type MockSessionServicerMockRecorder struct {
+	mock *MockSessionServicer
+}
+
+// NewMockSessionServicer creates a new mock instance.
+func NewMockSessionServicer(ctrl *gomock.Controller) *MockSessionServicer {
+	mock := &MockSessionServicer{ctrl: ctrl}
+	mock.recorder = &MockSessionServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionServicer) EXPECT() *MockSessionServicerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSessionServicer) Create(userID uuid.UUID, metadata model.SessionMetadata) (model.Tokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", userID, metadata)
+	ret0, _ := ret[0].(model.Tokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSessionServicerMockRecorder) Create(userID, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSessionServicer)(nil).Create), userID, metadata)
+}
+
+// GetAll mocks base method.
+func (m *MockSessionServicer) GetAll(userID, currentSessionID uuid.UUID) ([]model.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", userID, currentSessionID)
+	ret0, _ := ret[0].([]model.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSessionServicerMockRecorder) GetAll(userID, currentSessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSessionServicer)(nil).GetAll), userID, currentSessionID)
+}
+
+// Refresh mocks base method.
+func (m *MockSessionServicer) Refresh(refreshToken string, metadata model.SessionMetadata) (model.Tokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", refreshToken, metadata)
+	ret0, _ := ret[0].(model.Tokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockSessionServicerMockRecorder) Refresh(refreshToken, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockSessionServicer)(nil).Refresh), refreshToken, metadata)
+}
+
+// RequireRecentSignIn mocks base method.
+func (m *MockSessionServicer) RequireRecentSignIn(userID, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireRecentSignIn", userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireRecentSignIn indicates an expected call of RequireRecentSignIn.
+func (mr *MockSessionServicerMockRecorder) RequireRecentSignIn(userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireRecentSignIn", reflect.TypeOf((*MockSessionServicer)(nil).RequireRecentSignIn), userID, sessionID)
+}
+
+// Revoke mocks base method.
+func (m *MockSessionServicer) Revoke(refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockSessionServicerMockRecorder) Revoke(refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockSessionServicer)(nil).Revoke), refreshToken)
+}
+
+// RevokeAll mocks base method.
+func (m *MockSessionServicer) RevokeAll(userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAll", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAll indicates an expected call of RevokeAll.
+func (mr *MockSessionServicerMockRecorder) RevokeAll(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAll", reflect.TypeOf((*MockSessionServicer)(nil).RevokeAll), userID)
+}
+
+// RevokeByID mocks base method.
+func (m *MockSessionServicer) RevokeByID(userID, sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByID", userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByID indicates an expected call of RevokeByID.
+func (mr *MockSessionServicerMockRecorder) RevokeByID(userID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByID", reflect.TypeOf((*MockSessionServicer)(nil).RevokeByID), userID, sessionID)
+}
+
+// Validate mocks base method.
+func (m *MockSessionServicer) Validate(sessionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockSessionServicerMockRecorder) Validate(sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockSessionServicer)(nil).Validate), sessionID)
+}
+
+// MockPasswordResetServicer is a mock of PasswordResetServicer interface.
+type MockPasswordResetServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetServicerMockRecorder
+}
+
+// MockPasswordResetServicerMockRecorder is the mock recorder for MockPasswordResetServicer.
+type MockPasswordResetServicerMockRecorder struct {
+	mock *MockPasswordResetServicer
+}
+
+// NewMockPasswordResetServicer creates a new mock instance.
+func NewMockPasswordResetServicer(ctrl *gomock.Controller) *MockPasswordResetServicer {
+	mock := &MockPasswordResetServicer{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetServicer) EXPECT() *MockPasswordResetServicerMockRecorder {
+	return m.recorder
+}
+
+// RequestReset mocks base method.
+func (m *MockPasswordResetServicer) RequestReset(input model.ForgotPasswordDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReset", input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestReset indicates an expected call of RequestReset.
+func (mr *MockPasswordResetServicerMockRecorder) RequestReset(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReset", reflect.TypeOf((*MockPasswordResetServicer)(nil).RequestReset), input)
+}
+
+// Reset mocks base method.
+func (m *MockPasswordResetServicer) Reset(input model.ResetPasswordDTO) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", input)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockPasswordResetServicerMockRecorder) Reset(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockPasswordResetServicer)(nil).Reset), input)
+}
+
+// MockEmailVerificationServicer is a mock of EmailVerificationServicer interface.
+type MockEmailVerificationServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationServicerMockRecorder
+}
+
+// MockEmailVerificationServicerMockRecorder is the mock recorder for MockEmailVerificationServicer.
+type MockEmailVerificationServicerMockRecorder struct {
+	mock *MockEmailVerificationServicer
+}
+
+// NewMockEmailVerificationServicer creates a new mock instance.
+func NewMockEmailVerificationServicer(ctrl *gomock.Controller) *MockEmailVerificationServicer {
+	mock := &MockEmailVerificationServicer{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationServicer) EXPECT() *MockEmailVerificationServicerMockRecorder {
+	return m.recorder
+}
+
+// CheckAccess mocks base method.
+func (m *MockEmailVerificationServicer) CheckAccess(userID uuid.UUID, write bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", userID, write)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockEmailVerificationServicerMockRecorder) CheckAccess(userID, write interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockEmailVerificationServicer)(nil).CheckAccess), userID, write)
+}
+
+// Resend mocks base method.
+func (m *MockEmailVerificationServicer) Resend(input model.ResendVerificationDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockEmailVerificationServicerMockRecorder) Resend(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockEmailVerificationServicer)(nil).Resend), input)
+}
+
+// Send mocks base method.
+func (m *MockEmailVerificationServicer) Send(user model.User, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", user, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockEmailVerificationServicerMockRecorder) Send(user, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockEmailVerificationServicer)(nil).Send), user, email)
+}
+
+// Verify mocks base method.
+func (m *MockEmailVerificationServicer) Verify(token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockEmailVerificationServicerMockRecorder) Verify(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockEmailVerificationServicer)(nil).Verify), token)
+}
+
+// MockTwoFactorServicer is a mock of TwoFactorServicer interface.
+type MockTwoFactorServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTwoFactorServicerMockRecorder
+}
+
+// MockTwoFactorServicerMockRecorder is the mock recorder for MockTwoFactorServicer.
+type MockTwoFactorServicerMockRecorder struct {
+	mock *MockTwoFactorServicer
+}
+
+// NewMockTwoFactorServicer creates a new mock instance.
+func NewMockTwoFactorServicer(ctrl *gomock.Controller) *MockTwoFactorServicer {
+	mock := &MockTwoFactorServicer{ctrl: ctrl}
+	mock.recorder = &MockTwoFactorServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTwoFactorServicer) EXPECT() *MockTwoFactorServicerMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockTwoFactorServicer) Confirm(userID uuid.UUID, input model.TwoFactorCodeDTO) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", userID, input)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockTwoFactorServicerMockRecorder) Confirm(userID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockTwoFactorServicer)(nil).Confirm), userID, input)
+}
+
+// Disable mocks base method.
+func (m *MockTwoFactorServicer) Disable(userID uuid.UUID, input model.TwoFactorCodeDTO, metadata model.SessionMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", userID, input, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockTwoFactorServicerMockRecorder) Disable(userID, input, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockTwoFactorServicer)(nil).Disable), userID, input, metadata)
+}
+
+// Enroll mocks base method.
+func (m *MockTwoFactorServicer) Enroll(userID uuid.UUID) (model.TwoFactorEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", userID)
+	ret0, _ := ret[0].(model.TwoFactorEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockTwoFactorServicerMockRecorder) Enroll(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockTwoFactorServicer)(nil).Enroll), userID)
+}
+
+// SignIn mocks base method.
+func (m *MockTwoFactorServicer) SignIn(input model.TwoFactorSignInDTO, metadata model.SessionMetadata) (model.Tokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignIn", input, metadata)
+	ret0, _ := ret[0].(model.Tokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignIn indicates an expected call of SignIn.
+func (mr *MockTwoFactorServicerMockRecorder) SignIn(input, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignIn", reflect.TypeOf((*MockTwoFactorServicer)(nil).SignIn), input, metadata)
+}
+
+// MockAccessTokenServicer is a mock of AccessTokenServicer interface.
+type MockAccessTokenServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenServicerMockRecorder
+}
+
+// MockAccessTokenServicerMockRecorder is the mock recorder for MockAccessTokenServicer.
+type MockAccessTokenServicerMockRecorder struct {
+	mock *MockAccessTokenServicer
+}
+
+// NewMockAccessTokenServicer creates a new mock instance.
+func NewMockAccessTokenServicer(ctrl *gomock.Controller) *MockAccessTokenServicer {
+	mock := &MockAccessTokenServicer{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenServicer) EXPECT() *MockAccessTokenServicerMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAccessTokenServicer) Authenticate(plainToken string) (*model.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", plainToken)
+	ret0, _ := ret[0].(*model.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAccessTokenServicerMockRecorder) Authenticate(plainToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAccessTokenServicer)(nil).Authenticate), plainToken)
+}
+
+// Create mocks base method.
+func (m *MockAccessTokenServicer) Create(userID uuid.UUID, input model.CreatePersonalAccessTokenDTO) (model.CreatedPersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", userID, input)
+	ret0, _ := ret[0].(model.CreatedPersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAccessTokenServicerMockRecorder) Create(userID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccessTokenServicer)(nil).Create), userID, input)
+}
+
+// GetAll mocks base method.
+func (m *MockAccessTokenServicer) GetAll(userID uuid.UUID) ([]model.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", userID)
+	ret0, _ := ret[0].([]model.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAccessTokenServicerMockRecorder) GetAll(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAccessTokenServicer)(nil).GetAll), userID)
+}
+
+// Revoke mocks base method.
+func (m *MockAccessTokenServicer) Revoke(userID, tokenID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", userID, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAccessTokenServicerMockRecorder) Revoke(userID, tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAccessTokenServicer)(nil).Revoke), userID, tokenID)
+}
+
+// MockOIDCServicer is a mock of OIDCServicer interface.
+type MockOIDCServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCServicerMockRecorder
+}
+
+// MockOIDCServicerMockRecorder is the mock recorder for MockOIDCServicer.
+type MockOIDCServicerMockRecorder struct {
+	mock *MockOIDCServicer
+}
+
+// NewMockOIDCServicer creates a new mock instance.
+func NewMockOIDCServicer(ctrl *gomock.Controller) *MockOIDCServicer {
+	mock := &MockOIDCServicer{ctrl: ctrl}
+	mock.recorder = &MockOIDCServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCServicer) EXPECT() *MockOIDCServicerMockRecorder {
+	return m.recorder
+}
+
+// Callback mocks base method.
+func (m *MockOIDCServicer) Callback(provider string, input model.OIDCCallbackDTO, metadata model.SessionMetadata) (model.Tokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Callback", provider, input, metadata)
+	ret0, _ := ret[0].(model.Tokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Callback indicates an expected call of Callback.
+func (mr *MockOIDCServicerMockRecorder) Callback(provider, input, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Callback", reflect.TypeOf((*MockOIDCServicer)(nil).Callback), provider, input, metadata)
+}
+
+// Login mocks base method.
+func (m *MockOIDCServicer) Login(provider string) (model.OIDCLogin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", provider)
+	ret0, _ := ret[0].(model.OIDCLogin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockOIDCServicerMockRecorder) Login(provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockOIDCServicer)(nil).Login), provider)
+}
+
+// MockLoginAttemptServicer is a mock of LoginAttemptServicer interface.
+type MockLoginAttemptServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAttemptServicerMockRecorder
+}
+
+// MockLoginAttemptServicerMockRecorder is the mock recorder for MockLoginAttemptServicer.
+type MockLoginAttemptServicerMockRecorder struct {
+	mock *MockLoginAttemptServicer
+}
+
+// NewMockLoginAttemptServicer creates a new mock instance.
+func NewMockLoginAttemptServicer(ctrl *gomock.Controller) *MockLoginAttemptServicer {
+	mock := &MockLoginAttemptServicer{ctrl: ctrl}
+	mock.recorder = &MockLoginAttemptServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAttemptServicer) EXPECT() *MockLoginAttemptServicerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockLoginAttemptServicer) Check(email, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", email, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockLoginAttemptServicerMockRecorder) Check(email, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockLoginAttemptServicer)(nil).Check), email, ip)
+}
+
+// RegisterFailure mocks base method.
+func (m *MockLoginAttemptServicer) RegisterFailure(email, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailure", email, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterFailure indicates an expected call of RegisterFailure.
+func (mr *MockLoginAttemptServicerMockRecorder) RegisterFailure(email, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailure", reflect.TypeOf((*MockLoginAttemptServicer)(nil).RegisterFailure), email, ip)
+}
+
+// RegisterSuccess mocks base method.
+func (m *MockLoginAttemptServicer) RegisterSuccess(email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSuccess", email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterSuccess indicates an expected call of RegisterSuccess.
+func (mr *MockLoginAttemptServicerMockRecorder) RegisterSuccess(email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSuccess", reflect.TypeOf((*MockLoginAttemptServicer)(nil).RegisterSuccess), email)
+}
+
+// Unlock mocks base method.
+func (m *MockLoginAttemptServicer) Unlock(input model.UnlockSignInDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockLoginAttemptServicerMockRecorder) Unlock(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockLoginAttemptServicer)(nil).Unlock), input)
+}
+
+// MockAdminServicer is a mock of AdminServicer interface.
+type MockAdminServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServicerMockRecorder
+}
+
+// MockAdminServicerMockRecorder is the mock recorder for MockAdminServicer.
+type MockAdminServicerMockRecorder struct {
+	mock *MockAdminServicer
+}
+
+// NewMockAdminServicer creates a new mock instance.
+func NewMockAdminServicer(ctrl *gomock.Controller) *MockAdminServicer {
+	mock := &MockAdminServicer{ctrl: ctrl}
+	mock.recorder = &MockAdminServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminServicer) EXPECT() *MockAdminServicerMockRecorder {
+	return m.recorder
+}
+
+// Disable mocks base method.
+func (m *MockAdminServicer) Disable(actorID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", actorID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockAdminServicerMockRecorder) Disable(actorID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockAdminServicer)(nil).Disable), actorID, userID)
+}
+
+// Enable mocks base method.
+func (m *MockAdminServicer) Enable(userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enable", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enable indicates an expected call of Enable.
+func (mr *MockAdminServicerMockRecorder) Enable(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockAdminServicer)(nil).Enable), userID)
+}
+
+// GetStats mocks base method.
+func (m *MockAdminServicer) GetStats() (model.UsageStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStats")
+	ret0, _ := ret[0].(model.UsageStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStats indicates an expected call of GetStats.
+func (mr *MockAdminServicerMockRecorder) GetStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockAdminServicer)(nil).GetStats))
+}
+
+// GetUser mocks base method.
+func (m *MockAdminServicer) GetUser(userID uuid.UUID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockAdminServicerMockRecorder) GetUser(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockAdminServicer)(nil).GetUser), userID)
+}
+
+// GetUsers mocks base method.
+func (m *MockAdminServicer) GetUsers(search string, pagination *model.Pagination) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", search, pagination)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockAdminServicerMockRecorder) GetUsers(search, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockAdminServicer)(nil).GetUsers), search, pagination)
+}
+
+// PromoteAdmins mocks base method.
+func (m *MockAdminServicer) PromoteAdmins(emails []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteAdmins", emails)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PromoteAdmins indicates an expected call of PromoteAdmins.
+func (mr *MockAdminServicerMockRecorder) PromoteAdmins(emails interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteAdmins", reflect.TypeOf((*MockAdminServicer)(nil).PromoteAdmins), emails)
+}
+
+// ResetTwoFactor mocks base method.
+func (m *MockAdminServicer) ResetTwoFactor(userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetTwoFactor", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetTwoFactor indicates an expected call of ResetTwoFactor.
+func (mr *MockAdminServicerMockRecorder) ResetTwoFactor(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetTwoFactor", reflect.TypeOf((*MockAdminServicer)(nil).ResetTwoFactor), userID)
+}
+
+// SetPlan mocks base method.
+func (m *MockAdminServicer) SetPlan(userID uuid.UUID, input model.SetPlanDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPlan", userID, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPlan indicates an expected call of SetPlan.
+func (mr *MockAdminServicerMockRecorder) SetPlan(userID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPlan", reflect.TypeOf((*MockAdminServicer)(nil).SetPlan), userID, input)
+}
+
+// SetRole mocks base method.
+func (m *MockAdminServicer) SetRole(actorID, userID uuid.UUID, input model.SetRoleDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", actorID, userID, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockAdminServicerMockRecorder) SetRole(actorID, userID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockAdminServicer)(nil).SetRole), actorID, userID, input)
+}
+
+// MockTrashServicer is a mock of TrashServicer interface.
+type MockTrashServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashServicerMockRecorder
+}
+
+// MockTrashServicerMockRecorder is the mock recorder for MockTrashServicer.
+type MockTrashServicerMockRecorder struct {
+	mock *MockTrashServicer
+}
+
+// NewMockTrashServicer creates a new mock instance.
+func NewMockTrashServicer(ctrl *gomock.Controller) *MockTrashServicer {
+	mock := &MockTrashServicer{ctrl: ctrl}
+	mock.recorder = &MockTrashServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashServicer) EXPECT() *MockTrashServicerMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockTrashServicer) GetAll(userID uuid.UUID) ([]model.TrashEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", userID)
+	ret0, _ := ret[0].([]model.TrashEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTrashServicerMockRecorder) GetAll(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTrashServicer)(nil).GetAll), userID)
+}
+
+// Purge mocks base method.
+func (m *MockTrashServicer) Purge() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTrashServicerMockRecorder) Purge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTrashServicer)(nil).Purge))
+}
+
+// Restore mocks base method.
+func (m *MockTrashServicer) Restore(userID, entryID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", userID, entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTrashServicerMockRecorder) Restore(userID, entryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTrashServicer)(nil).Restore), userID, entryID)
+}
+
+// MockPlanServicer is a mock of PlanServicer interface.
+type MockPlanServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlanServicerMockRecorder
+}
+
+// MockPlanServicerMockRecorder is the mock recorder for MockPlanServicer.
+type MockPlanServicerMockRecorder struct {
+	mock *MockPlanServicer
+}
+
+// NewMockPlanServicer creates a new mock instance.
+func NewMockPlanServicer(ctrl *gomock.Controller) *MockPlanServicer {
+	mock := &MockPlanServicer{ctrl: ctrl}
+	mock.recorder = &MockPlanServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlanServicer) EXPECT() *MockPlanServicerMockRecorder {
+	return m.recorder
+}
+
+// GetUsage mocks base method.
+func (m *MockPlanServicer) GetUsage(userID uuid.UUID) (model.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", userID)
+	ret0, _ := ret[0].(model.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockPlanServicerMockRecorder) GetUsage(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockPlanServicer)(nil).GetUsage), userID)
+}
+
+// Sync mocks base method.
+func (m *MockPlanServicer) Sync(plans []model.Plan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", plans)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockPlanServicerMockRecorder) Sync(plans interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockPlanServicer)(nil).Sync), plans)
+}
+
+// MockInvitationServicer is a mock of InvitationServicer interface.
+type MockInvitationServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationServicerMockRecorder
+}
+
+// MockInvitationServicerMockRecorder is the mock recorder for MockInvitationServicer.
+type MockInvitationServicerMockRecorder struct {
+	mock *MockInvitationServicer
+}
+
+// NewMockInvitationServicer creates a new mock instance.
+func NewMockInvitationServicer(ctrl *gomock.Controller) *MockInvitationServicer {
+	mock := &MockInvitationServicer{ctrl: ctrl}
+	mock.recorder = &MockInvitationServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitationServicer) EXPECT() *MockInvitationServicerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockInvitationServicer) Create(createdBy uuid.UUID, input model.CreateInvitationDTO) (model.CreatedInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", createdBy, input)
+	ret0, _ := ret[0].(model.CreatedInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvitationServicerMockRecorder) Create(createdBy, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvitationServicer)(nil).Create), createdBy, input)
+}
+
+// GetAll mocks base method.
+func (m *MockInvitationServicer) GetAll() ([]model.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]model.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockInvitationServicerMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockInvitationServicer)(nil).GetAll))
+}
+
+// Revoke mocks base method.
+func (m *MockInvitationServicer) Revoke(invitationID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", invitationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockInvitationServicerMockRecorder) Revoke(invitationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockInvitationServicer)(nil).Revoke), invitationID)
+}
+
+// MockListInviteServicer is a mock of ListInviteServicer interface.
+type MockListInviteServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockListInviteServicerMockRecorder
+}
+
+// MockListInviteServicerMockRecorder is the mock recorder for MockListInviteServicer.
+type MockListInviteServicerMockRecorder struct {
+	mock *MockListInviteServicer
+}
+
+// NewMockListInviteServicer creates a new mock instance.
+func NewMockListInviteServicer(ctrl *gomock.Controller) *MockListInviteServicer {
+	mock := &MockListInviteServicer{ctrl: ctrl}
+	mock.recorder = &MockListInviteServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListInviteServicer) EXPECT() *MockListInviteServicerMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockListInviteServicer) Accept(userID uuid.UUID, token string) (model.TodoList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", userID, token)
+	ret0, _ := ret[0].(model.TodoList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockListInviteServicerMockRecorder) Accept(userID, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockListInviteServicer)(nil).Accept), userID, token)
+}
+
+// Create mocks base method.
+func (m *MockListInviteServicer) Create(userID, listID uuid.UUID, input model.CreateListInviteDTO) (model.CreatedListInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", userID, listID, input)
+	ret0, _ := ret[0].(model.CreatedListInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockListInviteServicerMockRecorder) Create(userID, listID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockListInviteServicer)(nil).Create), userID, listID, input)
+}
+
+// GetAll mocks base method.
+func (m *MockListInviteServicer) GetAll(userID, listID uuid.UUID) ([]model.ListInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", userID, listID)
+	ret0, _ := ret[0].([]model.ListInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockListInviteServicerMockRecorder) GetAll(userID, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockListInviteServicer)(nil).GetAll), userID, listID)
+}
+
+// Revoke mocks base method.
+func (m *MockListInviteServicer) Revoke(userID, listID, inviteID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", userID, listID, inviteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockListInviteServicerMockRecorder) Revoke(userID, listID, inviteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockListInviteServicer)(nil).Revoke), userID, listID, inviteID)
+}
+
+// MockListTransferServicer is a mock of ListTransferServicer interface.
+type MockListTransferServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockListTransferServicerMockRecorder
+}
+
+// MockListTransferServicerMockRecorder is the mock recorder for MockListTransferServicer.
+type MockListTransferServicerMockRecorder struct {
+	mock *MockListTransferServicer
+}
+
+// NewMockListTransferServicer creates a new mock instance.
+func NewMockListTransferServicer(ctrl *gomock.Controller) *MockListTransferServicer {
+	mock := &MockListTransferServicer{ctrl: ctrl}
+	mock.recorder = &MockListTransferServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListTransferServicer) EXPECT() *MockListTransferServicerMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockListTransferServicer) Accept(userID, transferID uuid.UUID) (model.TodoList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", userID, transferID)
+	ret0, _ := ret[0].(model.TodoList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockListTransferServicerMockRecorder) Accept(userID, transferID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockListTransferServicer)(nil).Accept), userID, transferID)
+}
+
+// Cancel mocks base method.
+func (m *MockListTransferServicer) Cancel(userID, listID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", userID, listID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockListTransferServicerMockRecorder) Cancel(userID, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockListTransferServicer)(nil).Cancel), userID, listID)
+}
+
+// Create mocks base method.
+func (m *MockListTransferServicer) Create(userID, listID uuid.UUID, input model.CreateListTransferDTO) (model.ListTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", userID, listID, input)
+	ret0, _ := ret[0].(model.ListTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockListTransferServicerMockRecorder) Create(userID, listID, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockListTransferServicer)(nil).Create), userID, listID, input)
+}
+
+// Decline mocks base method.
+func (m *MockListTransferServicer) Decline(userID, transferID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", userID, transferID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockListTransferServicerMockRecorder) Decline(userID, transferID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockListTransferServicer)(nil).Decline), userID, transferID)
+}
+
+// GetIncoming mocks base method.
+func (m *MockListTransferServicer) GetIncoming(userID uuid.UUID) ([]model.ListTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncoming", userID)
+	ret0, _ := ret[0].([]model.ListTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncoming indicates an expected call of GetIncoming.
+func (mr *MockListTransferServicerMockRecorder) GetIncoming(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncoming", reflect.TypeOf((*MockListTransferServicer)(nil).GetIncoming), userID)
+}
+
+// MockAuditServicer is a mock of AuditServicer interface.
+type MockAuditServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServicerMockRecorder
+}
+
+// MockAuditServicerMockRecorder is the mock recorder for MockAuditServicer.
+type MockAuditServicerMockRecorder struct {
+	mock *MockAuditServicer
+}
+
+// NewMockAuditServicer creates a new mock instance.
+func NewMockAuditServicer(ctrl *gomock.Controller) *MockAuditServicer {
+	mock := &MockAuditServicer{ctrl: ctrl}
+	mock.recorder = &MockAuditServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditServicer) EXPECT() *MockAuditServicerMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockAuditServicer) Find(input model.AuditFilterDTO, pagination *model.Pagination) ([]model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", input, pagination)
+	ret0, _ := ret[0].([]model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAuditServicerMockRecorder) Find(input, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAuditServicer)(nil).Find), input, pagination)
+}
+
+// GetForUser mocks base method.
+func (m *MockAuditServicer) GetForUser(userID uuid.UUID, pagination *model.Pagination) ([]model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUser", userID, pagination)
+	ret0, _ := ret[0].([]model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUser indicates an expected call of GetForUser.
+func (mr *MockAuditServicerMockRecorder) GetForUser(userID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUser", reflect.TypeOf((*MockAuditServicer)(nil).GetForUser), userID, pagination)
+}
+
+// Record mocks base method.
+func (m *MockAuditServicer) Record(event model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditServicerMockRecorder) Record(event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditServicer)(nil).Record), event)
+}
+
+// MockDataExportServicer is a mock of DataExportServicer interface.
+type MockDataExportServicer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataExportServicerMockRecorder
+}
+
+// MockDataExportServicerMockRecorder is the mock recorder for MockDataExportServicer.
+type MockDataExportServicerMockRecorder struct {
+	mock *MockDataExportServicer
+}
+
+// NewMockDataExportServicer creates a new mock instance.
+func NewMockDataExportServicer(ctrl *gomock.Controller) *MockDataExportServicer {
+	mock := &MockDataExportServicer{ctrl: ctrl}
+	mock.recorder = &MockDataExportServicerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataExportServicer) EXPECT() *MockDataExportServicerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDataExportServicer) Create(userID uuid.UUID) (*model.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", userID)
+	ret0, _ := ret[0].(*model.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataExportServicerMockRecorder) Create(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataExportServicer)(nil).Create), userID)
+}
+
+// Download mocks base method.
+func (m *MockDataExportServicer) Download(token string) (*model.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", token)
+	ret0, _ := ret[0].(*model.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockDataExportServicerMockRecorder) Download(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockDataExportServicer)(nil).Download), token)
+}
+
+// Get mocks base method.
+func (m *MockDataExportServicer) Get(userID, exportID uuid.UUID) (*model.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", userID, exportID)
+	ret0, _ := ret[0].(*model.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDataExportServicerMockRecorder) Get(userID, exportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDataExportServicer)(nil).Get), userID, exportID)
+}
+
+// Wait mocks base method.
+func (m *MockDataExportServicer) Wait(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockDataExportServicerMockRecorder) Wait(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockDataExportServicer)(nil).Wait), ctx)
+}
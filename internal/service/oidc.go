@@ -0,0 +1,276 @@
+package service
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/config"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+	"github.com/rtsoy/todo-app/pkg/oidc"
+)
+
+const (
+	oidcStateTokenTTL     = 10 * time.Minute
+	oidcStateLength       = 16
+	oidcUsernameAttempts  = 3
+	oidcUsernameMaxLength = 32
+
+	oidcStateTokenPurpose = "oidc"
+)
+
+var usernameDisallowedChars = regexp.MustCompile("[^a-zA-Z0-9_-]+")
+
+type oidcStateClaims struct {
+	model.RegisteredClaims
+	Provider string `json:"provider"`
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+type OIDCService struct {
+	providers    map[string]*oidc.Provider
+	identities   repository.UserIdentityRepository
+	users        repository.UserRepository
+	sessions     SessionServicer
+	keys         KeyServicer
+	registration RegistrationPolicy
+}
+
+func NewOIDCService(providers map[string]*oidc.Provider, identities repository.UserIdentityRepository,
+	users repository.UserRepository, sessions SessionServicer, keys KeyServicer, registration RegistrationPolicy) OIDCServicer {
+	return &OIDCService{
+		providers:    providers,
+		identities:   identities,
+		users:        users,
+		sessions:     sessions,
+		keys:         keys,
+		registration: registration,
+	}
+}
+
+func newOIDCProviders(providers []config.OIDCProvider) map[string]*oidc.Provider {
+	result := make(map[string]*oidc.Provider, len(providers))
+
+	for _, provider := range providers {
+		result[provider.Name] = oidc.NewProvider(oidc.Config{
+			IssuerURL:    provider.IssuerURL,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+		}, nil)
+	}
+
+	return result
+}
+
+// Login starts the authorization code flow. The state, nonce and PKCE verifier travel
+// in a signed state token the handler keeps in a cookie until the callback.
+func (s *OIDCService) Login(providerName string) (model.OIDCLogin, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return model.OIDCLogin{}, errors.New("unknown identity provider")
+	}
+
+	state, err := generateRandomToken(oidcStateLength)
+	if err != nil {
+		return model.OIDCLogin{}, err
+	}
+
+	nonce, err := generateRandomToken(oidcStateLength)
+	if err != nil {
+		return model.OIDCLogin{}, err
+	}
+
+	verifier, err := oidc.GenerateVerifier()
+	if err != nil {
+		return model.OIDCLogin{}, err
+	}
+
+	url, err := provider.AuthCodeURL(state, nonce, oidc.ChallengeS256(verifier))
+	if err != nil {
+		return model.OIDCLogin{}, err
+	}
+
+	stateToken, err := generateOIDCStateToken(s.keys, providerName, state, nonce, verifier)
+	if err != nil {
+		return model.OIDCLogin{}, err
+	}
+
+	return model.OIDCLogin{URL: url, StateToken: stateToken}, nil
+}
+
+func (s *OIDCService) Callback(providerName string, input model.OIDCCallbackDTO,
+	metadata model.SessionMetadata) (model.Tokens, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return model.Tokens{}, errors.New("unknown identity provider")
+	}
+
+	if input.Error != "" {
+		return model.Tokens{}, fmt.Errorf("identity provider returned an error: %s", input.Error)
+	}
+
+	claims, err := parseOIDCStateToken(s.keys, input.StateToken)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	if claims.Provider != providerName || subtle.ConstantTimeCompare([]byte(claims.State), []byte(input.State)) != 1 {
+		return model.Tokens{}, errors.New("invalid state")
+	}
+
+	token, err := provider.Exchange(input.Code, claims.Verifier)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	idClaims, err := provider.VerifyIDToken(token.IDToken, claims.Nonce)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	user, err := s.findOrCreateUser(providerName, idClaims)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	if user.TOTPEnabledAt != nil {
+		challengeToken, err := generateChallengeToken(s.keys, user.ID)
+		if err != nil {
+			return model.Tokens{}, err
+		}
+
+		return model.Tokens{ChallengeToken: challengeToken, UserID: user.ID}, nil
+	}
+
+	return s.sessions.Create(user.ID, metadata)
+}
+
+// findOrCreateUser resolves the local user for an external identity. Unknown identities are
+// linked to the account with the same email address, which is only safe because both the
+// provider and the account have verified the address; otherwise a new account is created.
+func (s *OIDCService) findOrCreateUser(providerName string, claims *oidc.Claims) (*model.User, error) {
+	identity, err := s.identities.GetBySubject(providerName, claims.Subject)
+	if err == nil {
+		return s.users.GetByID(identity.UserID)
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, errors.New("identity provider did not return a verified email")
+	}
+
+	email := normalizeLogin(claims.Email)
+
+	now := time.Now().UTC()
+	identity = &model.UserIdentity{
+		ID:        uuid.New(),
+		Provider:  providerName,
+		Subject:   claims.Subject,
+		Email:     email,
+		CreatedAt: now,
+	}
+
+	user, err := s.users.GetByEmail(email)
+	if err == nil {
+		// Anyone can sign up with an address they do not own, linking to such an account
+		// would hand the owner of the address an account someone else holds the password to
+		if user.VerifiedAt == nil {
+			return nil, errors.New("an account with this email exists but its email is not verified")
+		}
+
+		identity.UserID = user.ID
+		return user, s.identities.Create(*identity)
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	// There is no way to pass an invitation code through the provider, so invite-only
+	// registration only lets existing accounts link an identity
+	if err := s.registration.Check(email, false); err != nil {
+		return nil, err
+	}
+
+	user = &model.User{
+		ID:         uuid.New(),
+		Email:      email,
+		VerifiedAt: &now,
+	}
+	identity.UserID = user.ID
+
+	username := oidcUsername(claims)
+	for attempt := 0; attempt < oidcUsernameAttempts; attempt++ {
+		user.Username = username
+		if attempt > 0 {
+			suffix, err := generateRandomBytes(2)
+			if err != nil {
+				return nil, err
+			}
+
+			user.Username = fmt.Sprintf("%s-%x", username, suffix)
+		}
+
+		err = s.identities.CreateWithUser(*user, *identity)
+		if err == nil || err.Error() != "pq: duplicate key value violates unique constraint \"users_username_key\"" {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// oidcUsername derives a valid username from the preferred username or the email address.
+func oidcUsername(claims *oidc.Claims) string {
+	username := claims.PreferredUsername
+	if username == "" || strings.Contains(username, "@") {
+		username = strings.SplitN(claims.Email, "@", 2)[0]
+	}
+
+	username = truncate(usernameDisallowedChars.ReplaceAllString(normalizeLogin(username), "-"), oidcUsernameMaxLength)
+	if !isUsernameValid(username) {
+		username = "user-" + truncate(usernameDisallowedChars.ReplaceAllString(normalizeLogin(claims.Subject), "-"), 8)
+	}
+
+	return username
+}
+
+func generateOIDCStateToken(keys KeyServicer, provider, state, nonce, verifier string) (string, error) {
+	claims := oidcStateClaims{
+		Provider: provider,
+		State:    state,
+		Nonce:    nonce,
+		Verifier: verifier,
+	}
+
+	return keys.Sign(&claims, oidcStateTokenPurpose, oidcStateTokenTTL)
+}
+
+func parseOIDCStateToken(keys KeyServicer, stateToken string) (*oidcStateClaims, error) {
+	var claims oidcStateClaims
+	if err := keys.Parse(stateToken, oidcStateTokenPurpose, &claims); err != nil {
+		if err.Error() == "token is expired" {
+			return nil, errors.New("sign-in attempt has expired")
+		}
+
+		return nil, errors.New("invalid state")
+	}
+
+	return &claims, nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	mock_repository "github.com/rtsoy/todo-app/internal/repository/mocks"
+	"github.com/rtsoy/todo-app/pkg/oidc"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOIDCService_findOrCreateUser(t *testing.T) {
+	type mockBehavior func(identities *mock_repository.MockUserIdentityRepository,
+		users *mock_repository.MockUserRepository, user *model.User)
+
+	verifiedAt := time.Unix(0, 0).UTC()
+	claims := &oidc.Claims{Subject: "subject", Email: "Test@Example.com", EmailVerified: true}
+
+	tests := []struct {
+		name          string
+		claims        *oidc.Claims
+		user          *model.User
+		mockBehavior  mockBehavior
+		expectedUser  bool
+		expectedError string
+	}{
+		{
+			name:   "Known Identity",
+			claims: claims,
+			user:   &model.User{ID: uuid.New(), Email: "test@example.com"},
+			mockBehavior: func(identities *mock_repository.MockUserIdentityRepository,
+				users *mock_repository.MockUserRepository, user *model.User) {
+				identities.EXPECT().GetBySubject("test", "subject").Return(&model.UserIdentity{UserID: user.ID}, nil)
+				users.EXPECT().GetByID(user.ID).Return(user, nil)
+			},
+			expectedUser: true,
+		},
+		{
+			name:   "Link Verified Account",
+			claims: claims,
+			user:   &model.User{ID: uuid.New(), Email: "test@example.com", VerifiedAt: &verifiedAt},
+			mockBehavior: func(identities *mock_repository.MockUserIdentityRepository,
+				users *mock_repository.MockUserRepository, user *model.User) {
+				identities.EXPECT().GetBySubject("test", "subject").Return(nil, sql.ErrNoRows)
+				users.EXPECT().GetByEmail("test@example.com").Return(user, nil)
+				identities.EXPECT().Create(gomock.Any()).DoAndReturn(func(identity model.UserIdentity) error {
+					assert.Equal(t, user.ID, identity.UserID)
+					return nil
+				})
+			},
+			expectedUser: true,
+		},
+		{
+			name:   "Unverified Account",
+			claims: claims,
+			user:   &model.User{ID: uuid.New(), Email: "test@example.com"},
+			mockBehavior: func(identities *mock_repository.MockUserIdentityRepository,
+				users *mock_repository.MockUserRepository, user *model.User) {
+				identities.EXPECT().GetBySubject("test", "subject").Return(nil, sql.ErrNoRows)
+				users.EXPECT().GetByEmail("test@example.com").Return(user, nil)
+			},
+			expectedError: "an account with this email exists but its email is not verified",
+		},
+		{
+			name:   "Unverified Provider Email",
+			claims: &oidc.Claims{Subject: "subject", Email: "test@example.com"},
+			mockBehavior: func(identities *mock_repository.MockUserIdentityRepository,
+				users *mock_repository.MockUserRepository, user *model.User) {
+				identities.EXPECT().GetBySubject("test", "subject").Return(nil, sql.ErrNoRows)
+			},
+			expectedError: "identity provider did not return a verified email",
+		},
+		{
+			name:   "Repository Failure",
+			claims: claims,
+			mockBehavior: func(identities *mock_repository.MockUserIdentityRepository,
+				users *mock_repository.MockUserRepository, user *model.User) {
+				identities.EXPECT().GetBySubject("test", "subject").Return(nil, errors.New("repository failure"))
+			},
+			expectedError: "repository failure",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			identities := mock_repository.NewMockUserIdentityRepository(c)
+			users := mock_repository.NewMockUserRepository(c)
+			test.mockBehavior(identities, users, test.user)
+
+			s := &OIDCService{
+				identities:   identities,
+				users:        users,
+				registration: RegistrationPolicy{Mode: model.RegistrationOpen},
+			}
+
+			user, err := s.findOrCreateUser("test", test.claims)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+				assert.Nil(t, user)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.user, user)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/pkg/breach"
+)
+
+// PasswordPolicy is checked whenever a password is set. MaxLength is counted in bytes because
+// bcrypt ignores everything after the 72nd byte. A nil BreachList skips the breach check.
+type PasswordPolicy struct {
+	MinLength           int
+	MaxLength           int
+	MinCharacterClasses int
+	MinEntropyBits      float64
+	BreachList          *breach.List
+}
+
+// Validate returns a *model.PasswordPolicyError with every rule the password fails.
+func (p PasswordPolicy) Validate(password string) error {
+	var violations []model.PasswordViolation
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, model.PasswordViolation{
+			Rule:    model.PasswordRuleMinLength,
+			Message: fmt.Sprintf("password must be at least %d characters long", p.MinLength),
+		})
+	}
+
+	if p.MaxLength > 0 && len(password) > p.MaxLength {
+		violations = append(violations, model.PasswordViolation{
+			Rule:    model.PasswordRuleMaxLength,
+			Message: fmt.Sprintf("password must not be longer than %d bytes", p.MaxLength),
+		})
+	}
+
+	if classes := characterClasses(password); len(classes) < p.MinCharacterClasses {
+		violations = append(violations, model.PasswordViolation{
+			Rule: model.PasswordRuleCharacterClasses,
+			Message: fmt.Sprintf("password must contain at least %d of: lowercase letters, uppercase letters, "+
+				"digits, symbols", p.MinCharacterClasses),
+		})
+	}
+
+	if estimateEntropy(password) < p.MinEntropyBits {
+		violations = append(violations, model.PasswordViolation{
+			Rule:    model.PasswordRuleEntropy,
+			Message: "password is too easy to guess, use a longer password with fewer repeated characters",
+		})
+	}
+
+	if p.BreachList != nil {
+		count, err := p.BreachList.Count(password)
+		if err != nil {
+			return errors.New("failed to check password")
+		}
+
+		if count > 0 {
+			violations = append(violations, model.PasswordViolation{
+				Rule:    model.PasswordRuleBreached,
+				Message: "password has appeared in a data breach, choose a different one",
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return &model.PasswordPolicyError{Violations: violations}
+	}
+
+	return nil
+}
+
+// characterClasses maps every class used in the password to the number of characters in it.
+func characterClasses(password string) map[string]int {
+	classes := make(map[string]int)
+
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			classes["lower"] = 26
+		case unicode.IsUpper(r):
+			classes["upper"] = 26
+		case unicode.IsDigit(r):
+			classes["digit"] = 10
+		default:
+			classes["symbol"] = 33
+		}
+	}
+
+	return classes
+}
+
+// estimateEntropy is a rough upper bound of the bits needed to brute force the password:
+// its length times the bits per character of the classes it uses. Repeated characters only
+// count half, so "aaaaaaaaaaaa1" does not score like a random string of the same length.
+func estimateEntropy(password string) float64 {
+	pool := 0
+	for _, size := range characterClasses(password) {
+		pool += size
+	}
+
+	if pool == 0 {
+		return 0
+	}
+
+	seen := make(map[rune]bool)
+	length := 0.0
+
+	for _, r := range password {
+		if seen[r] {
+			length += 0.5
+			continue
+		}
+
+		seen[r] = true
+		length++
+	}
+
+	return length * math.Log2(float64(pool))
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+	"github.com/rtsoy/todo-app/pkg/mail"
+	"github.com/rtsoy/todo-app/pkg/passhash"
+)
+
+const (
+	passwordResetTokenTTL    = time.Hour
+	passwordResetTokenLength = 32
+)
+
+type PasswordResetService struct {
+	repository     repository.PasswordResetRepository
+	userRepository repository.UserRepository
+	mailer         *BackgroundMailer
+	passwords      PasswordPolicy
+	hasher         *passhash.Hasher
+	appURL         string
+}
+
+func NewPasswordResetService(repository repository.PasswordResetRepository, userRepository repository.UserRepository,
+	mailer *BackgroundMailer, passwords PasswordPolicy, hasher *passhash.Hasher, appURL string) PasswordResetServicer {
+	return &PasswordResetService{
+		repository:     repository,
+		userRepository: userRepository,
+		mailer:         mailer,
+		passwords:      passwords,
+		hasher:         hasher,
+		appURL:         appURL,
+	}
+}
+
+// RequestReset emails a reset link to the owner of the address. Unknown addresses
+// are not reported, so the endpoint cannot be used to enumerate accounts. The email
+// is sent in the background, neither a slow nor a failing mailer tells the two apart.
+func (s *PasswordResetService) RequestReset(input model.ForgotPasswordDTO) error {
+	user, err := s.userRepository.GetByEmail(input.Email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	token, err := generateRandomToken(passwordResetTokenLength)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	resetToken := model.PasswordResetToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		CreatedAt: now,
+		ExpiresAt: now.Add(passwordResetTokenTTL),
+	}
+
+	if err := s.repository.Create(resetToken); err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", s.appURL, url.QueryEscape(token))
+	s.mailer.Send(mail.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to choose a new password. It expires in %s.\n\n%s\n\n"+
+			"If you did not ask for a password reset, you can ignore this email.\n",
+			user.Username, passwordResetTokenTTL, link),
+	})
+
+	return nil
+}
+
+// Reset sets the new password and returns the user it belongs to.
+func (s *PasswordResetService) Reset(input model.ResetPasswordDTO) (uuid.UUID, error) {
+	token, err := s.repository.GetByTokenHash(hashToken(input.Token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, errors.New("invalid or expired reset token")
+		}
+
+		return uuid.Nil, err
+	}
+
+	if token.UsedAt != nil || time.Now().UTC().After(token.ExpiresAt) {
+		return uuid.Nil, errors.New("invalid or expired reset token")
+	}
+
+	if err := s.passwords.Validate(input.Password); err != nil {
+		return uuid.Nil, err
+	}
+
+	hashedPassword, err := s.hasher.Hash(input.Password)
+	if err != nil {
+		return uuid.Nil, errors.New("failed to hash password")
+	}
+
+	if err := s.repository.Use(token.ID, token.UserID, hashedPassword); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, errors.New("invalid or expired reset token")
+		}
+
+		return uuid.Nil, err
+	}
+
+	return token.UserID, nil
+}
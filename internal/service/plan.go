@@ -0,0 +1,49 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+type PlanService struct {
+	repository repository.PlanRepository
+}
+
+func NewPlanService(repository repository.PlanRepository) PlanServicer {
+	return &PlanService{
+		repository: repository,
+	}
+}
+
+// Sync writes the configured quotas to the database, it runs on startup so changing
+// a quota only takes a restart.
+func (s *PlanService) Sync(plans []model.Plan) error {
+	for _, plan := range plans {
+		if plan.MaxLists < 0 || plan.MaxItemsPerList < 0 || plan.MaxMembersPerList < 0 || plan.MaxAttachments < 0 {
+			return errors.New("quotas of the " + plan.Name + " plan cannot be negative")
+		}
+
+		if err := s.repository.Save(plan); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *PlanService) GetUsage(userID uuid.UUID) (model.Usage, error) {
+	usage, err := s.repository.GetUsage(userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Usage{}, errors.New("user not found")
+		}
+
+		return model.Usage{}, err
+	}
+
+	return usage, nil
+}
@@ -1,10 +1,18 @@
 package service
 
 import (
-	"github.com/golang-jwt/jwt"
+	"context"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/config"
 	"github.com/rtsoy/todo-app/internal/model"
 	"github.com/rtsoy/todo-app/internal/repository"
+	"github.com/rtsoy/todo-app/pkg/breach"
+	"github.com/rtsoy/todo-app/pkg/jwk"
+	"github.com/rtsoy/todo-app/pkg/mail"
+	"github.com/rtsoy/todo-app/pkg/passhash"
+	"github.com/sirupsen/logrus"
 )
 
 //go:generate mockgen -source=service.go -destination=mocks/mock.go
@@ -19,28 +27,224 @@ type TodoItemServicer interface {
 
 type TodoListServicer interface {
 	Create(userID uuid.UUID, list model.CreateTodoListDTO) (uuid.UUID, error)
-	GetAll(userID uuid.UUID, orderBy *string) ([]model.TodoList, error)
+	GetAll(userID uuid.UUID, archived bool, orderBy *string) ([]model.TodoList, error)
 	GetByID(userID, listID uuid.UUID) (model.TodoList, error)
 	Update(userID, listID uuid.UUID, data model.UpdateTodoListDTO) error
 	Delete(userID, listID uuid.UUID) error
+	Archive(userID, listID uuid.UUID) error
+	Unarchive(userID, listID uuid.UUID) error
+	AddMember(userID, listID uuid.UUID, input model.AddListMemberDTO) (model.ListMember, error)
+	UpdateMember(userID, listID, memberID uuid.UUID, input model.UpdateListMemberDTO) error
+	GetMembers(userID, listID uuid.UUID) ([]model.ListMember, error)
+	RemoveMember(userID, listID, memberID uuid.UUID) error
+}
+
+type KeyServicer interface {
+	Load() error
+	Sign(claims model.Claims, purpose string, ttl time.Duration) (string, error)
+	Parse(token, purpose string, claims model.Claims) error
+	JWKS() (jwk.Set, error)
 }
 
 type UserServicer interface {
 	CreateUser(user model.CreateUserDTO) (uuid.UUID, error)
-	GenerateToken(email, password string) (string, error)
-	ParseToken(accessToken string) (jwt.MapClaims, error)
+	GenerateToken(login, password string, metadata model.SessionMetadata) (model.Tokens, error)
+	ParseToken(accessToken string) (*model.AccessTokenClaims, error)
+	GetByID(userID uuid.UUID) (*model.User, error)
+	Update(userID uuid.UUID, input model.UpdateUserDTO) (*model.User, error)
+	ChangePassword(userID, sessionID uuid.UUID, input model.ChangePasswordDTO, metadata model.SessionMetadata) error
+	Delete(userID, sessionID uuid.UUID, input model.DeleteUserDTO, metadata model.SessionMetadata) error
+}
+
+type SessionServicer interface {
+	Create(userID uuid.UUID, metadata model.SessionMetadata) (model.Tokens, error)
+	Refresh(refreshToken string, metadata model.SessionMetadata) (model.Tokens, error)
+	Revoke(refreshToken string) error
+	Validate(sessionID uuid.UUID) error
+	RequireRecentSignIn(userID, sessionID uuid.UUID) error
+	GetAll(userID, currentSessionID uuid.UUID) ([]model.Session, error)
+	RevokeByID(userID, sessionID uuid.UUID) error
+	RevokeAll(userID uuid.UUID) error
+}
+
+type PasswordResetServicer interface {
+	RequestReset(input model.ForgotPasswordDTO) error
+	Reset(input model.ResetPasswordDTO) (uuid.UUID, error)
+}
+
+type EmailVerificationServicer interface {
+	Send(user model.User, email string) error
+	Resend(input model.ResendVerificationDTO) error
+	Verify(token string) error
+	CheckAccess(userID uuid.UUID, write bool) error
+}
+
+type TwoFactorServicer interface {
+	Enroll(userID uuid.UUID) (model.TwoFactorEnrollment, error)
+	Confirm(userID uuid.UUID, input model.TwoFactorCodeDTO) ([]string, error)
+	Disable(userID uuid.UUID, input model.TwoFactorCodeDTO, metadata model.SessionMetadata) error
+	SignIn(input model.TwoFactorSignInDTO, metadata model.SessionMetadata) (model.Tokens, error)
+}
+
+type AccessTokenServicer interface {
+	Create(userID uuid.UUID, input model.CreatePersonalAccessTokenDTO) (model.CreatedPersonalAccessToken, error)
+	GetAll(userID uuid.UUID) ([]model.PersonalAccessToken, error)
+	Revoke(userID, tokenID uuid.UUID) error
+	Authenticate(plainToken string) (*model.PersonalAccessToken, error)
+}
+
+type OIDCServicer interface {
+	Login(provider string) (model.OIDCLogin, error)
+	Callback(provider string, input model.OIDCCallbackDTO, metadata model.SessionMetadata) (model.Tokens, error)
+}
+
+type LoginAttemptServicer interface {
+	Check(email, ip string) error
+	RegisterFailure(email, ip string) error
+	RegisterSuccess(email string) error
+	Unlock(input model.UnlockSignInDTO) error
+}
+
+type AdminServicer interface {
+	PromoteAdmins(emails []string) error
+	GetUsers(search string, pagination *model.Pagination) ([]model.User, error)
+	GetUser(userID uuid.UUID) (*model.User, error)
+	SetRole(actorID, userID uuid.UUID, input model.SetRoleDTO) error
+	Disable(actorID, userID uuid.UUID) error
+	Enable(userID uuid.UUID) error
+	ResetTwoFactor(userID uuid.UUID) error
+	SetPlan(userID uuid.UUID, input model.SetPlanDTO) error
+	GetStats() (model.UsageStats, error)
+}
+
+type TrashServicer interface {
+	GetAll(userID uuid.UUID) ([]model.TrashEntry, error)
+	Restore(userID, entryID uuid.UUID) error
+	Purge() error
+}
+
+type PlanServicer interface {
+	Sync(plans []model.Plan) error
+	GetUsage(userID uuid.UUID) (model.Usage, error)
+}
+
+type InvitationServicer interface {
+	Create(createdBy uuid.UUID, input model.CreateInvitationDTO) (model.CreatedInvitation, error)
+	GetAll() ([]model.Invitation, error)
+	Revoke(invitationID uuid.UUID) error
+}
+
+type ListInviteServicer interface {
+	Create(userID, listID uuid.UUID, input model.CreateListInviteDTO) (model.CreatedListInvite, error)
+	GetAll(userID, listID uuid.UUID) ([]model.ListInvite, error)
+	Revoke(userID, listID, inviteID uuid.UUID) error
+	Accept(userID uuid.UUID, token string) (model.TodoList, error)
+}
+
+type ListTransferServicer interface {
+	Create(userID, listID uuid.UUID, input model.CreateListTransferDTO) (model.ListTransfer, error)
+	GetIncoming(userID uuid.UUID) ([]model.ListTransfer, error)
+	Cancel(userID, listID uuid.UUID) error
+	Accept(userID, transferID uuid.UUID) (model.TodoList, error)
+	Decline(userID, transferID uuid.UUID) error
+}
+
+type AuditServicer interface {
+	Record(event model.AuditEvent) error
+	GetForUser(userID uuid.UUID, pagination *model.Pagination) ([]model.AuditEvent, error)
+	Find(input model.AuditFilterDTO, pagination *model.Pagination) ([]model.AuditEvent, error)
+}
+
+type DataExportServicer interface {
+	Create(userID uuid.UUID) (*model.DataExport, error)
+	Get(userID, exportID uuid.UUID) (*model.DataExport, error)
+	Download(token string) (*model.DataExport, error)
+	Wait(ctx context.Context) error
 }
 
 type Service struct {
-	UserService     UserServicer
-	TodoListService TodoListServicer
-	TodoItemService TodoItemServicer
+	KeyService               KeyServicer
+	UserService              UserServicer
+	SessionService           SessionServicer
+	PasswordResetService     PasswordResetServicer
+	EmailVerificationService EmailVerificationServicer
+	TwoFactorService         TwoFactorServicer
+	AccessTokenService       AccessTokenServicer
+	OIDCService              OIDCServicer
+	LoginAttemptService      LoginAttemptServicer
+	AdminService             AdminServicer
+	PlanService              PlanServicer
+	InvitationService        InvitationServicer
+	AuditService             AuditServicer
+	DataExportService        DataExportServicer
+	TodoListService          TodoListServicer
+	ListInviteService        ListInviteServicer
+	ListTransferService      ListTransferServicer
+	TodoItemService          TodoItemServicer
+	TrashService             TrashServicer
+
+	// BackgroundMailer sends the emails that must not hold up or fail a request
+	BackgroundMailer *BackgroundMailer
 }
 
-func NewService(repository *repository.Repository) *Service {
+func NewService(cfg *config.Config, repository *repository.Repository, mailer mail.Sender,
+	hasher *passhash.Hasher, log *logrus.Logger) *Service {
+	backgroundMailer := NewBackgroundMailer(mailer, log)
+	keyService := NewKeyService(repository.SigningKeyRepository, cfg.JWTSigningAlgorithm,
+		cfg.JWTKeyRotationInterval, cfg.JWTKeyVerificationPeriod, cfg.JWTIssuer, cfg.JWTAudience, cfg.JWTClockSkew)
+	sessionService := NewSessionService(repository.SessionRepository, repository.UserRepository, keyService)
+	emailVerificationService := NewEmailVerificationService(repository.EmailVerificationRepository,
+		repository.UserRepository, mailer, backgroundMailer, cfg.AppURL, cfg.EmailVerificationPolicy)
+	loginAttemptService := NewLoginAttemptService(repository.LoginAttemptRepository, LoginAttemptPolicy{
+		MaxAccountFailures: cfg.LoginMaxAccountFailures,
+		MaxIPFailures:      cfg.LoginMaxIPFailures,
+		FailureWindow:      cfg.LoginFailureWindow,
+		LockoutBase:        cfg.LoginLockoutBase,
+		LockoutMax:         cfg.LoginLockoutMax,
+	})
+
+	passwordPolicy := PasswordPolicy{
+		MinLength:           cfg.PasswordMinLength,
+		MaxLength:           cfg.PasswordMaxLength,
+		MinCharacterClasses: cfg.PasswordMinCharacterClasses,
+		MinEntropyBits:      cfg.PasswordMinEntropyBits,
+	}
+	if cfg.PasswordBreachListDir != "" {
+		passwordPolicy.BreachList = breach.NewList(cfg.PasswordBreachListDir)
+	}
+
+	registrationPolicy := RegistrationPolicy{
+		Mode:           cfg.RegistrationMode,
+		AllowedDomains: cfg.RegistrationAllowedDomains,
+	}
+
 	return &Service{
-		TodoItemService: NewTodoItemService(repository.TodoItemRepository, repository.TodoListRepository),
-		TodoListService: NewTodoListService(repository.TodoListRepository),
-		UserService:     NewUserService(repository.UserRepository),
+		KeyService:               keyService,
+		TodoItemService:          NewTodoItemService(repository.TodoItemRepository, repository.TodoListRepository),
+		TodoListService:          NewTodoListService(repository.TodoListRepository, repository.UserRepository),
+		TrashService:             NewTrashService(repository.TrashRepository, cfg.TrashRetention),
+		SessionService:           sessionService,
+		EmailVerificationService: emailVerificationService,
+		UserService: NewUserService(repository.UserRepository, sessionService, emailVerificationService, keyService,
+			loginAttemptService, passwordPolicy, hasher, registrationPolicy),
+		TwoFactorService: NewTwoFactorService(repository.TwoFactorRepository, repository.UserRepository, sessionService,
+			keyService, loginAttemptService),
+		LoginAttemptService: loginAttemptService,
+		AdminService:        NewAdminService(repository.UserRepository, repository.TwoFactorRepository, repository.StatsRepository),
+		PlanService:         NewPlanService(repository.PlanRepository),
+		InvitationService:   NewInvitationService(repository.InvitationRepository),
+		AuditService:        NewAuditService(repository.AuditRepository, repository.UserRepository),
+		DataExportService: NewDataExportService(repository.DataExportRepository, repository.UserRepository,
+			repository.TodoListRepository, repository.TodoItemRepository, keyService, cfg.APIURL, log),
+		AccessTokenService: NewAccessTokenService(repository.AccessTokenRepository),
+		ListInviteService: NewListInviteService(repository.ListInviteRepository, repository.TodoListRepository,
+			cfg.AppURL),
+		ListTransferService: NewListTransferService(repository.ListTransferRepository, repository.TodoListRepository,
+			repository.UserRepository),
+		OIDCService: NewOIDCService(newOIDCProviders(cfg.OIDCProviders), repository.UserIdentityRepository,
+			repository.UserRepository, sessionService, keyService, registrationPolicy),
+		PasswordResetService: NewPasswordResetService(repository.PasswordResetRepository, repository.UserRepository,
+			backgroundMailer, passwordPolicy, hasher, cfg.AppURL),
+		BackgroundMailer: backgroundMailer,
 	}
 }
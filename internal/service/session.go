@@ -0,0 +1,275 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+const (
+	refreshTokenTTL    = 30 * 24 * time.Hour
+	refreshTokenLength = 32
+	maxUserAgentLength = 512
+
+	// recentSignInWindow is how long after signing in an account without a password
+	// may still delete itself or set a password
+	recentSignInWindow = 10 * time.Minute
+)
+
+type SessionService struct {
+	repository     repository.SessionRepository
+	userRepository repository.UserRepository
+	keys           KeyServicer
+}
+
+func NewSessionService(repository repository.SessionRepository, userRepository repository.UserRepository,
+	keys KeyServicer) SessionServicer {
+	return &SessionService{
+		repository:     repository,
+		userRepository: userRepository,
+		keys:           keys,
+	}
+}
+
+func (s *SessionService) Create(userID uuid.UUID, metadata model.SessionMetadata) (model.Tokens, error) {
+	user, err := s.getActiveUser(userID)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	refreshToken, err := generateRandomToken(refreshTokenLength)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	now := time.Now().UTC()
+	session := model.Session{
+		ID:               uuid.New(),
+		UserID:           userID,
+		RefreshTokenHash: hashToken(refreshToken),
+		CreatedAt:        now,
+		ExpiresAt:        now.Add(refreshTokenTTL),
+		UserAgent:        truncate(metadata.UserAgent, maxUserAgentLength),
+		IP:               metadata.IP,
+		LastSeenAt:       now,
+	}
+
+	if err := s.repository.Create(session); err != nil {
+		return model.Tokens{}, err
+	}
+
+	accessToken, err := generateAccessToken(s.keys, user, session.ID)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	return model.Tokens{AccessToken: accessToken, RefreshToken: refreshToken, UserID: userID}, nil
+}
+
+func (s *SessionService) Refresh(refreshToken string, metadata model.SessionMetadata) (model.Tokens, error) {
+	session, err := s.getByRefreshToken(refreshToken)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	user, err := s.getActiveUser(session.UserID)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	newRefreshToken, err := generateRandomToken(refreshTokenLength)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	metadata.UserAgent = truncate(metadata.UserAgent, maxUserAgentLength)
+
+	expiresAt := time.Now().UTC().Add(refreshTokenTTL)
+	if err := s.repository.Rotate(session.ID, session.RefreshTokenHash, hashToken(newRefreshToken), expiresAt, metadata); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Tokens{}, errors.New("invalid refresh token")
+		}
+
+		return model.Tokens{}, err
+	}
+
+	accessToken, err := generateAccessToken(s.keys, user, session.ID)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	return model.Tokens{AccessToken: accessToken, RefreshToken: newRefreshToken, UserID: user.ID}, nil
+}
+
+func (s *SessionService) Revoke(refreshToken string) error {
+	session, err := s.getByRefreshToken(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.Revoke(session.ID)
+}
+
+func (s *SessionService) Validate(sessionID uuid.UUID) error {
+	session, err := s.repository.GetByID(sessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("session not found")
+		}
+
+		return err
+	}
+
+	return checkSessionActive(session)
+}
+
+// RequireRecentSignIn makes sure the session was started a moment ago. Accounts created
+// through an identity provider have no password to confirm sensitive actions with, so
+// a fresh sign-in with the provider takes its place.
+func (s *SessionService) RequireRecentSignIn(userID, sessionID uuid.UUID) error {
+	session, err := s.repository.GetByID(sessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("session not found")
+		}
+
+		return err
+	}
+
+	if session.UserID != userID || time.Now().UTC().Sub(session.CreatedAt) > recentSignInWindow {
+		return errors.New("sign in again to confirm this action")
+	}
+
+	return nil
+}
+
+func (s *SessionService) GetAll(userID, currentSessionID uuid.UUID) ([]model.Session, error) {
+	sessions, err := s.repository.GetAllActive(userID)
+	if err != nil {
+		return sessions, err
+	}
+
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == currentSessionID
+	}
+
+	return sessions, nil
+}
+
+func (s *SessionService) RevokeByID(userID, sessionID uuid.UUID) error {
+	if err := s.repository.RevokeByID(userID, sessionID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("session not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *SessionService) RevokeAll(userID uuid.UUID) error {
+	return s.repository.RevokeAll(userID)
+}
+
+func (s *SessionService) getByRefreshToken(refreshToken string) (*model.Session, error) {
+	session, err := s.repository.GetByRefreshTokenHash(hashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("invalid refresh token")
+		}
+
+		return nil, err
+	}
+
+	if err := checkSessionActive(session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// getActiveUser returns the user a session is created or refreshed for, disabled accounts get no tokens.
+func (s *SessionService) getActiveUser(userID uuid.UUID) (*model.User, error) {
+	user, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.DisabledAt != nil {
+		return nil, errors.New("account is disabled")
+	}
+
+	return user, nil
+}
+
+func checkSessionActive(session *model.Session) error {
+	if session.RevokedAt != nil {
+		return errors.New("session has been revoked")
+	}
+
+	if time.Now().UTC().After(session.ExpiresAt) {
+		return errors.New("session has expired")
+	}
+
+	return nil
+}
+
+// truncate cuts the value to at most length bytes without splitting a multi-byte character.
+func truncate(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+
+	for length > 0 && !utf8.RuneStart(value[length]) {
+		length--
+	}
+
+	return value[:length]
+}
+
+// Returns a URL-safe random string built from n bytes of crypto/rand output.
+func generateRandomToken(n int) (string, error) {
+	bytes, err := generateRandomBytes(n)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+func generateRandomBytes(n int) ([]byte, error) {
+	bytes := make([]byte, n)
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, err
+	}
+
+	return bytes, nil
+}
+
+// toUTC returns a copy of the optional time in UTC. Times from clients carry their own offset,
+// which TIMESTAMP columns drop, so they are converted before they are stored.
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	utc := t.UTC()
+
+	return &utc
+}
+
+// Tokens handed out to clients are only ever stored as their SHA-256 digest.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		length   int
+		expected string
+	}{
+		{name: "Short", value: "agent", length: 10, expected: "agent"},
+		{name: "Exact", value: "agent", length: 5, expected: "agent"},
+		{name: "ASCII", value: "agent", length: 3, expected: "age"},
+		{name: "Rune Boundary", value: "añb", length: 3, expected: "añ"},
+		{name: "Inside Rune", value: "añb", length: 2, expected: "a"},
+		{name: "Inside Four Byte Rune", value: "a😀", length: 4, expected: "a"},
+		{name: "Zero", value: "ñ", length: 0, expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, truncate(test.value, test.length))
+		})
+	}
+}
@@ -30,8 +30,18 @@ func NewTodoItemService(repository repository.TodoItemRepository, listRepository
 }
 
 func (s *TodoItemService) Create(userID, listID uuid.UUID, item model.CreateTodoItemDTO) (uuid.UUID, error) {
-	if _, err := s.listRepository.GetByID(userID, listID); err != nil {
-		return uuid.Nil, errors.New("forbidden")
+	list, err := s.listRepository.GetByID(userID, listID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, errors.New("todo list not found")
+		}
+
+		return uuid.Nil, err
+	}
+
+	if err := checkListWritable(list, model.ListRoleEditor,
+		"you do not have permission to change items of this list"); err != nil {
+		return uuid.Nil, err
 	}
 
 	if len(item.Title) < minItemTitleLength {
@@ -51,7 +61,16 @@ func (s *TodoItemService) Create(userID, listID uuid.UUID, item model.CreateTodo
 		return uuid.Nil, errors.New("deadline cannot be in the past")
 	}
 
-	return s.repository.Create(listID, item)
+	itemID, err := s.repository.Create(listID, item)
+	if err != nil {
+		if errors.Is(err, repository.ErrQuotaExceeded) {
+			return uuid.Nil, errors.New("exceeded the maximum allowed number of items in the list")
+		}
+
+		return uuid.Nil, err
+	}
+
+	return itemID, nil
 }
 
 func (s *TodoItemService) GetAll(userID, listID uuid.UUID, pagination *model.Pagination, orderBy *string) ([]model.TodoItem, error) {
@@ -109,6 +128,10 @@ func (s *TodoItemService) Update(userID, itemID uuid.UUID, data model.UpdateTodo
 		return errors.New("description length is too short")
 	}
 
+	if err := s.checkRole(userID, itemID); err != nil {
+		return err
+	}
+
 	item, _ := s.repository.GetByID(userID, itemID)
 	if data.Deadline != nil && item.CreatedAt.After(*data.Deadline) {
 		return errors.New("deadline cannot be in the past")
@@ -118,9 +141,28 @@ func (s *TodoItemService) Update(userID, itemID uuid.UUID, data model.UpdateTodo
 }
 
 func (s *TodoItemService) Delete(userID, itemID uuid.UUID) error {
+	if err := s.checkRole(userID, itemID); err != nil {
+		return err
+	}
+
 	return s.repository.Delete(userID, itemID)
 }
 
+// checkRole makes sure the user may change the item, editors and owners of its list can
+// unless the list is archived.
+func (s *TodoItemService) checkRole(userID, itemID uuid.UUID) error {
+	list, err := s.repository.GetList(userID, itemID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("todo item not found")
+		}
+
+		return err
+	}
+
+	return checkListWritable(list, model.ListRoleEditor, "you do not have permission to change items of this list")
+}
+
 func verifyItemOrderByString(orderBy *string) *string {
 	value := *orderBy
 
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	mock_repository "github.com/rtsoy/todo-app/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoItemService_Permissions(t *testing.T) {
+	type mockBehavior func(items *mock_repository.MockTodoItemRepository, lists *mock_repository.MockTodoListRepository,
+		userID, id uuid.UUID, list model.TodoList, allowed bool)
+
+	title := "new title"
+	editors := map[string]bool{model.ListRoleEditor: true, model.ListRoleOwner: true}
+
+	tests := []struct {
+		name         string
+		archived     bool
+		allowed      map[string]bool
+		denied       string
+		mockBehavior mockBehavior
+		call         func(s *TodoItemService, userID, id uuid.UUID) error
+	}{
+		{
+			name:    "Create",
+			allowed: editors,
+			denied:  "you do not have permission to change items of this list",
+			mockBehavior: func(items *mock_repository.MockTodoItemRepository, lists *mock_repository.MockTodoListRepository,
+				userID, listID uuid.UUID, list model.TodoList, allowed bool) {
+				lists.EXPECT().GetByID(userID, listID).Return(list, nil)
+				if allowed {
+					items.EXPECT().Create(listID, gomock.Any()).Return(uuid.New(), nil)
+				}
+			},
+			call: func(s *TodoItemService, userID, listID uuid.UUID) error {
+				_, err := s.Create(userID, listID, model.CreateTodoItemDTO{Title: "title", Description: "description"})
+				return err
+			},
+		},
+		{
+			name:    "Update",
+			allowed: editors,
+			denied:  "you do not have permission to change items of this list",
+			mockBehavior: func(items *mock_repository.MockTodoItemRepository, lists *mock_repository.MockTodoListRepository,
+				userID, itemID uuid.UUID, list model.TodoList, allowed bool) {
+				items.EXPECT().GetList(userID, itemID).Return(list, nil)
+				if allowed {
+					items.EXPECT().GetByID(userID, itemID).Return(model.TodoItem{ID: itemID}, nil)
+					items.EXPECT().Update(userID, itemID, model.UpdateTodoItemDTO{Title: &title}).Return(nil)
+				}
+			},
+			call: func(s *TodoItemService, userID, itemID uuid.UUID) error {
+				return s.Update(userID, itemID, model.UpdateTodoItemDTO{Title: &title})
+			},
+		},
+		{
+			name:    "Delete",
+			allowed: editors,
+			denied:  "you do not have permission to change items of this list",
+			mockBehavior: func(items *mock_repository.MockTodoItemRepository, lists *mock_repository.MockTodoListRepository,
+				userID, itemID uuid.UUID, list model.TodoList, allowed bool) {
+				items.EXPECT().GetList(userID, itemID).Return(list, nil)
+				if allowed {
+					items.EXPECT().Delete(userID, itemID).Return(nil)
+				}
+			},
+			call: func(s *TodoItemService, userID, itemID uuid.UUID) error {
+				return s.Delete(userID, itemID)
+			},
+		},
+		{
+			name:     "Delete From Archived List",
+			archived: true,
+			allowed:  map[string]bool{},
+			denied:   "todo list is archived",
+			mockBehavior: func(items *mock_repository.MockTodoItemRepository, lists *mock_repository.MockTodoListRepository,
+				userID, itemID uuid.UUID, list model.TodoList, allowed bool) {
+				items.EXPECT().GetList(userID, itemID).Return(list, nil)
+			},
+			call: func(s *TodoItemService, userID, itemID uuid.UUID) error {
+				return s.Delete(userID, itemID)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for _, role := range listRoles {
+			test, role := test, role
+
+			t.Run(test.name+" As "+role, func(t *testing.T) {
+				c := gomock.NewController(t)
+				defer c.Finish()
+				userID := uuid.New()
+				id := uuid.New()
+
+				list := model.TodoList{ID: uuid.New(), Role: role}
+				if test.archived {
+					archivedAt := time.Now().UTC()
+					list.ArchivedAt = &archivedAt
+				}
+
+				items := mock_repository.NewMockTodoItemRepository(c)
+				lists := mock_repository.NewMockTodoListRepository(c)
+				test.mockBehavior(items, lists, userID, id, list, test.allowed[role])
+
+				s := &TodoItemService{repository: items, listRepository: lists}
+
+				err := test.call(s, userID, id)
+				if test.allowed[role] {
+					assert.NoError(t, err)
+					return
+				}
+
+				// Below editor the role is refused before the archive is looked at
+				if test.archived && !editors[role] {
+					assert.EqualError(t, err, "you do not have permission to change items of this list")
+					return
+				}
+
+				assert.EqualError(t, err, test.denied)
+			})
+		}
+	}
+}
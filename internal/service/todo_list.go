@@ -5,6 +5,7 @@ import (
 	"errors"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rtsoy/todo-app/internal/model"
@@ -14,29 +15,34 @@ import (
 const (
 	minListTitleLength       = 3
 	minListDescriptionLength = 3
-	maxListsPerUser          = 5
 )
 
+// listRoleRanks orders the roles on a list, a role can do everything the roles below it can.
+var listRoleRanks = map[string]int{
+	model.ListRoleViewer:    1,
+	model.ListRoleCommenter: 2,
+	model.ListRoleEditor:    3,
+	model.ListRoleOwner:     4,
+}
+
+// hasListRole reports whether role grants at least the access of minRole.
+func hasListRole(role, minRole string) bool {
+	return listRoleRanks[role] >= listRoleRanks[minRole]
+}
+
 type TodoListService struct {
-	repository repository.TodoListRepository
+	repository     repository.TodoListRepository
+	userRepository repository.UserRepository
 }
 
-func NewTodoListService(repository repository.TodoListRepository) TodoListServicer {
+func NewTodoListService(repository repository.TodoListRepository, userRepository repository.UserRepository) TodoListServicer {
 	return &TodoListService{
-		repository: repository,
+		repository:     repository,
+		userRepository: userRepository,
 	}
 }
 
 func (s *TodoListService) Create(userID uuid.UUID, list model.CreateTodoListDTO) (uuid.UUID, error) {
-	totalLists, err := s.repository.GetAll(userID, nil)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return uuid.Nil, err
-	}
-
-	if len(totalLists) >= maxListsPerUser {
-		return uuid.Nil, errors.New("exceeded the maximum allowed limit of existing lists")
-	}
-
 	if len(list.Title) < minListTitleLength {
 		return uuid.Nil, errors.New("title length is too short")
 	}
@@ -45,15 +51,26 @@ func (s *TodoListService) Create(userID uuid.UUID, list model.CreateTodoListDTO)
 		return uuid.Nil, errors.New("description length is too short")
 	}
 
-	return s.repository.Create(userID, list)
+	// Lists shared with the user do not count towards the quota of their plan
+	listID, err := s.repository.Create(userID, list)
+	if err != nil {
+		if errors.Is(err, repository.ErrQuotaExceeded) {
+			return uuid.Nil, errors.New("exceeded the maximum allowed limit of existing lists")
+		}
+
+		return uuid.Nil, err
+	}
+
+	return listID, nil
 }
 
-func (s *TodoListService) GetAll(userID uuid.UUID, orderBy *string) ([]model.TodoList, error) {
+// GetAll returns the active lists of the user, or only the archived ones when archived is set.
+func (s *TodoListService) GetAll(userID uuid.UUID, archived bool, orderBy *string) ([]model.TodoList, error) {
 	if orderBy != nil {
 		orderBy = verifyListOrderByString(orderBy)
 	}
 
-	lists, err := s.repository.GetAll(userID, orderBy)
+	lists, err := s.repository.GetAll(userID, &archived, orderBy)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return lists, errors.New("no todo lists found")
@@ -95,13 +112,227 @@ func (s *TodoListService) Update(userID, listID uuid.UUID, data model.UpdateTodo
 		return errors.New("description length is too short")
 	}
 
+	list, err := s.GetByID(userID, listID)
+	if err != nil {
+		return err
+	}
+
+	if err := checkListWritable(list, model.ListRoleEditor, "you do not have permission to change this list"); err != nil {
+		return err
+	}
+
 	return s.repository.Update(userID, listID, data)
 }
 
 func (s *TodoListService) Delete(userID, listID uuid.UUID) error {
+	if err := s.checkRole(userID, listID, model.ListRoleOwner, "only the owner can delete the list"); err != nil {
+		return err
+	}
+
 	return s.repository.Delete(userID, listID)
 }
 
+// Archive hides the list from the default list view and makes it read-only. It no longer
+// counts towards the list quota of the owner. Only the owner can archive a list.
+func (s *TodoListService) Archive(userID, listID uuid.UUID) error {
+	if err := s.checkRole(userID, listID, model.ListRoleOwner, "only the owner can archive the list"); err != nil {
+		return err
+	}
+
+	if err := s.repository.Archive(listID, time.Now().UTC()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("todo list is already archived")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Unarchive makes the list active again, as long as the owner has room for it in their plan.
+func (s *TodoListService) Unarchive(userID, listID uuid.UUID) error {
+	if err := s.checkRole(userID, listID, model.ListRoleOwner, "only the owner can unarchive the list"); err != nil {
+		return err
+	}
+
+	if err := s.repository.Unarchive(userID, listID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("todo list is not archived")
+		}
+
+		if errors.Is(err, repository.ErrQuotaExceeded) {
+			return errors.New("exceeded the maximum allowed limit of existing lists")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// AddMember shares the list with the user found by username or email. Only the owner can share a list.
+func (s *TodoListService) AddMember(userID, listID uuid.UUID, input model.AddListMemberDTO) (model.ListMember, error) {
+	if input.Role == "" {
+		input.Role = model.ListRoleEditor
+	}
+
+	if err := validateMemberRole(input.Role); err != nil {
+		return model.ListMember{}, err
+	}
+
+	if err := s.checkRole(userID, listID, model.ListRoleOwner, "only the owner can manage members"); err != nil {
+		return model.ListMember{}, err
+	}
+
+	user, err := s.userRepository.GetByLogin(normalizeLogin(input.Login))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ListMember{}, errors.New("user not found")
+		}
+
+		return model.ListMember{}, err
+	}
+
+	if err := s.repository.AddMember(listID, user.ID, input.Role); err != nil {
+		if err.Error() == "pq: duplicate key value violates unique constraint \"users_lists_user_id_list_id_key\"" {
+			return model.ListMember{}, errors.New("user is already a member")
+		}
+
+		if errors.Is(err, repository.ErrQuotaExceeded) {
+			return model.ListMember{}, errors.New("exceeded the maximum allowed number of members in the list")
+		}
+
+		return model.ListMember{}, err
+	}
+
+	return model.ListMember{
+		UserID:   user.ID,
+		Username: user.Username,
+		Email:    user.Email,
+		Role:     input.Role,
+		AddedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// GetMembers returns the owner and everyone the list is shared with. Any member can see them.
+func (s *TodoListService) GetMembers(userID, listID uuid.UUID) ([]model.ListMember, error) {
+	if _, err := s.getRole(userID, listID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.repository.GetMembers(listID)
+	if err != nil {
+		return nil, err
+	}
+
+	if members == nil {
+		members = []model.ListMember{}
+	}
+
+	return members, nil
+}
+
+// UpdateMember changes the role of a member. Only the owner can change roles and the owner
+// keeps their own role.
+func (s *TodoListService) UpdateMember(userID, listID, memberID uuid.UUID, input model.UpdateListMemberDTO) error {
+	if err := validateMemberRole(input.Role); err != nil {
+		return err
+	}
+
+	if err := s.checkRole(userID, listID, model.ListRoleOwner, "only the owner can manage members"); err != nil {
+		return err
+	}
+
+	if err := s.repository.UpdateMemberRole(listID, memberID, input.Role); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("member not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// RemoveMember stops sharing the list with the member. The owner can remove anyone but
+// themselves, other members can only leave the list.
+func (s *TodoListService) RemoveMember(userID, listID, memberID uuid.UUID) error {
+	role, err := s.getRole(userID, listID)
+	if err != nil {
+		return err
+	}
+
+	if role != model.ListRoleOwner && userID != memberID {
+		return errors.New("only the owner can manage members")
+	}
+
+	if role == model.ListRoleOwner && userID == memberID {
+		return errors.New("the owner cannot leave the list")
+	}
+
+	if err := s.repository.RemoveMember(listID, memberID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("member not found")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *TodoListService) getRole(userID, listID uuid.UUID) (string, error) {
+	role, err := s.repository.GetRole(userID, listID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.New("todo list not found")
+		}
+
+		return "", err
+	}
+
+	return role, nil
+}
+
+// checkRole returns an error with the denied message when the user's role on the list is below minRole.
+func (s *TodoListService) checkRole(userID, listID uuid.UUID, minRole, denied string) error {
+	role, err := s.getRole(userID, listID)
+	if err != nil {
+		return err
+	}
+
+	if !hasListRole(role, minRole) {
+		return errors.New(denied)
+	}
+
+	return nil
+}
+
+// checkListWritable returns an error with the denied message when the user's role on the list
+// is below minRole, and refuses any change to an archived list.
+func checkListWritable(list model.TodoList, minRole, denied string) error {
+	if !hasListRole(list.Role, minRole) {
+		return errors.New(denied)
+	}
+
+	if list.ArchivedAt != nil {
+		return errors.New("todo list is archived")
+	}
+
+	return nil
+}
+
+// validateMemberRole only allows the roles the owner can hand out, ownership cannot be given away.
+func validateMemberRole(role string) error {
+	switch role {
+	case model.ListRoleEditor, model.ListRoleCommenter, model.ListRoleViewer:
+		return nil
+	default:
+		return errors.New("role must be editor, commenter or viewer")
+	}
+}
+
 func verifyListOrderByString(orderBy *string) *string {
 	value := *orderBy
 
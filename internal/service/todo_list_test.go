@@ -0,0 +1,222 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	mock_repository "github.com/rtsoy/todo-app/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// listRoles are all the roles a member can have on a list, from least to most access.
+var listRoles = []string{model.ListRoleViewer, model.ListRoleCommenter, model.ListRoleEditor, model.ListRoleOwner}
+
+func TestTodoListService_Permissions(t *testing.T) {
+	type mockBehavior func(lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+		userID, listID uuid.UUID, role string, allowed bool)
+
+	title := "new title"
+	member := &model.User{ID: uuid.New(), Username: "member", Email: "member@example.com"}
+
+	tests := []struct {
+		name         string
+		allowed      map[string]bool
+		denied       string
+		mockBehavior mockBehavior
+		call         func(s *TodoListService, userID, listID uuid.UUID) error
+	}{
+		{
+			name:    "Update",
+			allowed: map[string]bool{model.ListRoleEditor: true, model.ListRoleOwner: true},
+			denied:  "you do not have permission to change this list",
+			mockBehavior: func(lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string, allowed bool) {
+				lists.EXPECT().GetByID(userID, listID).Return(model.TodoList{ID: listID, Role: role}, nil)
+				if allowed {
+					lists.EXPECT().Update(userID, listID, model.UpdateTodoListDTO{Title: &title}).Return(nil)
+				}
+			},
+			call: func(s *TodoListService, userID, listID uuid.UUID) error {
+				return s.Update(userID, listID, model.UpdateTodoListDTO{Title: &title})
+			},
+		},
+		{
+			name:    "Delete",
+			allowed: map[string]bool{model.ListRoleOwner: true},
+			denied:  "only the owner can delete the list",
+			mockBehavior: func(lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string, allowed bool) {
+				lists.EXPECT().GetRole(userID, listID).Return(role, nil)
+				if allowed {
+					lists.EXPECT().Delete(userID, listID).Return(nil)
+				}
+			},
+			call: func(s *TodoListService, userID, listID uuid.UUID) error {
+				return s.Delete(userID, listID)
+			},
+		},
+		{
+			name:    "Archive",
+			allowed: map[string]bool{model.ListRoleOwner: true},
+			denied:  "only the owner can archive the list",
+			mockBehavior: func(lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string, allowed bool) {
+				lists.EXPECT().GetRole(userID, listID).Return(role, nil)
+				if allowed {
+					lists.EXPECT().Archive(listID, gomock.Any()).Return(nil)
+				}
+			},
+			call: func(s *TodoListService, userID, listID uuid.UUID) error {
+				return s.Archive(userID, listID)
+			},
+		},
+		{
+			name:    "Unarchive",
+			allowed: map[string]bool{model.ListRoleOwner: true},
+			denied:  "only the owner can unarchive the list",
+			mockBehavior: func(lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string, allowed bool) {
+				lists.EXPECT().GetRole(userID, listID).Return(role, nil)
+				if allowed {
+					lists.EXPECT().Unarchive(userID, listID).Return(nil)
+				}
+			},
+			call: func(s *TodoListService, userID, listID uuid.UUID) error {
+				return s.Unarchive(userID, listID)
+			},
+		},
+		{
+			name:    "Add Member",
+			allowed: map[string]bool{model.ListRoleOwner: true},
+			denied:  "only the owner can manage members",
+			mockBehavior: func(lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string, allowed bool) {
+				lists.EXPECT().GetRole(userID, listID).Return(role, nil)
+				if allowed {
+					users.EXPECT().GetByLogin("member").Return(member, nil)
+					lists.EXPECT().AddMember(listID, member.ID, model.ListRoleViewer).Return(nil)
+				}
+			},
+			call: func(s *TodoListService, userID, listID uuid.UUID) error {
+				_, err := s.AddMember(userID, listID, model.AddListMemberDTO{Login: "member", Role: model.ListRoleViewer})
+				return err
+			},
+		},
+		{
+			name:    "Update Member",
+			allowed: map[string]bool{model.ListRoleOwner: true},
+			denied:  "only the owner can manage members",
+			mockBehavior: func(lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string, allowed bool) {
+				lists.EXPECT().GetRole(userID, listID).Return(role, nil)
+				if allowed {
+					lists.EXPECT().UpdateMemberRole(listID, member.ID, model.ListRoleEditor).Return(nil)
+				}
+			},
+			call: func(s *TodoListService, userID, listID uuid.UUID) error {
+				return s.UpdateMember(userID, listID, member.ID, model.UpdateListMemberDTO{Role: model.ListRoleEditor})
+			},
+		},
+		{
+			name:    "Remove Member",
+			allowed: map[string]bool{model.ListRoleOwner: true},
+			denied:  "only the owner can manage members",
+			mockBehavior: func(lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string, allowed bool) {
+				lists.EXPECT().GetRole(userID, listID).Return(role, nil)
+				if allowed {
+					lists.EXPECT().RemoveMember(listID, member.ID).Return(nil)
+				}
+			},
+			call: func(s *TodoListService, userID, listID uuid.UUID) error {
+				return s.RemoveMember(userID, listID, member.ID)
+			},
+		},
+		{
+			name: "Leave",
+			allowed: map[string]bool{model.ListRoleViewer: true, model.ListRoleCommenter: true,
+				model.ListRoleEditor: true},
+			denied: "the owner cannot leave the list",
+			mockBehavior: func(lists *mock_repository.MockTodoListRepository, users *mock_repository.MockUserRepository,
+				userID, listID uuid.UUID, role string, allowed bool) {
+				lists.EXPECT().GetRole(userID, listID).Return(role, nil)
+				if allowed {
+					lists.EXPECT().RemoveMember(listID, userID).Return(nil)
+				}
+			},
+			call: func(s *TodoListService, userID, listID uuid.UUID) error {
+				return s.RemoveMember(userID, listID, userID)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for _, role := range listRoles {
+			test, role := test, role
+
+			t.Run(test.name+" As "+role, func(t *testing.T) {
+				c := gomock.NewController(t)
+				defer c.Finish()
+				userID := uuid.New()
+				listID := uuid.New()
+
+				lists := mock_repository.NewMockTodoListRepository(c)
+				users := mock_repository.NewMockUserRepository(c)
+				test.mockBehavior(lists, users, userID, listID, role, test.allowed[role])
+
+				s := &TodoListService{repository: lists, userRepository: users}
+
+				err := test.call(s, userID, listID)
+				if test.allowed[role] {
+					assert.NoError(t, err)
+					return
+				}
+
+				assert.EqualError(t, err, test.denied)
+			})
+		}
+	}
+}
+
+func TestTodoListService_UpdateArchived(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+	userID := uuid.New()
+	listID := uuid.New()
+	archivedAt := time.Now().UTC()
+	title := "new title"
+
+	lists := mock_repository.NewMockTodoListRepository(c)
+	lists.EXPECT().GetByID(userID, listID).Return(model.TodoList{ID: listID, Role: model.ListRoleOwner,
+		ArchivedAt: &archivedAt}, nil)
+
+	s := &TodoListService{repository: lists}
+
+	err := s.Update(userID, listID, model.UpdateTodoListDTO{Title: &title})
+	assert.EqualError(t, err, "todo list is archived")
+}
+
+func TestHasListRole(t *testing.T) {
+	tests := []struct {
+		role     string
+		minRole  string
+		expected bool
+	}{
+		{role: model.ListRoleOwner, minRole: model.ListRoleOwner, expected: true},
+		{role: model.ListRoleOwner, minRole: model.ListRoleEditor, expected: true},
+		{role: model.ListRoleEditor, minRole: model.ListRoleOwner, expected: false},
+		{role: model.ListRoleEditor, minRole: model.ListRoleEditor, expected: true},
+		{role: model.ListRoleCommenter, minRole: model.ListRoleEditor, expected: false},
+		{role: model.ListRoleCommenter, minRole: model.ListRoleViewer, expected: true},
+		{role: model.ListRoleViewer, minRole: model.ListRoleCommenter, expected: false},
+		{role: model.ListRoleViewer, minRole: model.ListRoleViewer, expected: true},
+		{role: "", minRole: model.ListRoleViewer, expected: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, hasListRole(test.role, test.minRole), test.role+" as "+test.minRole)
+	}
+}
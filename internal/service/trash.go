@@ -0,0 +1,89 @@
+package service
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+)
+
+type TrashService struct {
+	repository repository.TrashRepository
+	retention  time.Duration
+}
+
+func NewTrashService(repository repository.TrashRepository, retention time.Duration) TrashServicer {
+	return &TrashService{
+		repository: repository,
+		retention:  retention,
+	}
+}
+
+// GetAll returns the deleted lists the user owns and the deleted items of the lists they can
+// edit, with the time each of them is purged.
+func (s *TrashService) GetAll(userID uuid.UUID) ([]model.TrashEntry, error) {
+	entries, err := s.repository.GetAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if entries == nil {
+		entries = []model.TrashEntry{}
+	}
+
+	for i := range entries {
+		entries[i].PurgeAt = entries[i].DeletedAt.Add(s.retention)
+	}
+
+	return entries, nil
+}
+
+// Restore takes a list or an item out of the trash. Restored lists and items count towards
+// the quotas again, so there has to be room for them.
+func (s *TrashService) Restore(userID, entryID uuid.UUID) error {
+	entry, err := s.repository.GetByID(userID, entryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("trash entry not found")
+		}
+
+		return err
+	}
+
+	switch entry.Type {
+	case model.TrashTypeList:
+		err = s.repository.RestoreList(userID, entry.ID)
+	default:
+		if entry.ListArchivedAt != nil {
+			return errors.New("todo list is archived")
+		}
+
+		err = s.repository.RestoreItem(*entry.ListID, entry.ID)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("trash entry not found")
+		}
+
+		if errors.Is(err, repository.ErrQuotaExceeded) {
+			if entry.Type == model.TrashTypeList {
+				return errors.New("exceeded the maximum allowed limit of existing lists")
+			}
+
+			return errors.New("exceeded the maximum allowed number of items in the list")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Purge permanently removes what has been in the trash for longer than the retention period.
+func (s *TrashService) Purge() error {
+	return s.repository.Purge(time.Now().UTC().Add(-s.retention))
+}
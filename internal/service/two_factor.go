@@ -0,0 +1,233 @@
+package service
+
+import (
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	"github.com/rtsoy/todo-app/internal/repository"
+	"github.com/rtsoy/todo-app/pkg/totp"
+)
+
+const (
+	totpIssuer          = "TodoApp"
+	totpSkew            = 1
+	recoveryCodesCount  = 10
+	recoveryCodeLength  = 10
+	recoveryCodeGroupBy = 4
+)
+
+type TwoFactorService struct {
+	repository     repository.TwoFactorRepository
+	userRepository repository.UserRepository
+	sessions       SessionServicer
+	keys           KeyServicer
+	loginAttempts  LoginAttemptServicer
+}
+
+func NewTwoFactorService(repository repository.TwoFactorRepository, userRepository repository.UserRepository,
+	sessions SessionServicer, keys KeyServicer, loginAttempts LoginAttemptServicer) TwoFactorServicer {
+	return &TwoFactorService{
+		repository:     repository,
+		userRepository: userRepository,
+		sessions:       sessions,
+		keys:           keys,
+		loginAttempts:  loginAttempts,
+	}
+}
+
+// Enroll creates a new secret for the user. 2FA stays disabled until the secret is
+// confirmed with a code from the authenticator app.
+func (s *TwoFactorService) Enroll(userID uuid.UUID) (model.TwoFactorEnrollment, error) {
+	user, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		return model.TwoFactorEnrollment{}, err
+	}
+
+	if user.TOTPEnabledAt != nil {
+		return model.TwoFactorEnrollment{}, errors.New("two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return model.TwoFactorEnrollment{}, err
+	}
+
+	if err := s.repository.SetSecret(userID, secret); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.TwoFactorEnrollment{}, errors.New("two-factor authentication is already enabled")
+		}
+
+		return model.TwoFactorEnrollment{}, err
+	}
+
+	return model.TwoFactorEnrollment{
+		Secret: secret,
+		URI:    totp.URI(totpIssuer, user.Email, secret),
+	}, nil
+}
+
+// Confirm enables 2FA and returns the recovery codes. They are only stored hashed,
+// so this is the only time they can be shown to the user.
+func (s *TwoFactorService) Confirm(userID uuid.UUID, input model.TwoFactorCodeDTO) ([]string, error) {
+	user, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.TOTPEnabledAt != nil {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+
+	if user.TOTPSecret == nil {
+		return nil, errors.New("two-factor authentication enrolment has not been started")
+	}
+
+	step, ok := totp.Validate(*user.TOTPSecret, input.Code, time.Now().UTC(), totpSkew)
+	if !ok {
+		return nil, errors.New("invalid two-factor code")
+	}
+
+	codes := make([]string, recoveryCodesCount)
+	hashes := make([]string, recoveryCodesCount)
+
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+
+		codes[i] = code
+		hashes[i] = hashToken(normalizeRecoveryCode(code))
+	}
+
+	if err := s.repository.Enable(userID, step, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Disable turns 2FA off with a code from the authenticator app or a recovery code. Wrong
+// codes count towards the sign-in lockout, so a stolen session cannot guess its way in.
+func (s *TwoFactorService) Disable(userID uuid.UUID, input model.TwoFactorCodeDTO, metadata model.SessionMetadata) error {
+	user, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		return err
+	}
+
+	if user.TOTPEnabledAt == nil {
+		return errors.New("two-factor authentication is not enabled")
+	}
+
+	if err := s.loginAttempts.Check(user.Email, metadata.IP); err != nil {
+		return err
+	}
+
+	if err := s.checkCode(user, input.Code); err != nil {
+		if failureErr := s.loginAttempts.RegisterFailure(user.Email, metadata.IP); failureErr != nil {
+			return failureErr
+		}
+
+		return err
+	}
+
+	if err := s.loginAttempts.RegisterSuccess(user.Email); err != nil {
+		return err
+	}
+
+	return s.repository.Disable(userID)
+}
+
+// SignIn completes a sign-in started with a password, accepting either a code from
+// the authenticator app or an unused recovery code.
+func (s *TwoFactorService) SignIn(input model.TwoFactorSignInDTO, metadata model.SessionMetadata) (model.Tokens, error) {
+	userID, err := parseChallengeToken(s.keys, input.ChallengeToken)
+	if err != nil {
+		return model.Tokens{}, err
+	}
+
+	user, err := s.userRepository.GetByID(userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Tokens{}, errors.New("invalid challenge token")
+		}
+
+		return model.Tokens{}, err
+	}
+
+	if user.TOTPEnabledAt == nil {
+		return model.Tokens{}, errors.New("two-factor authentication is not enabled")
+	}
+
+	if err := s.loginAttempts.Check(user.Email, metadata.IP); err != nil {
+		return model.Tokens{}, err
+	}
+
+	if err := s.checkCode(user, input.Code); err != nil {
+		if failureErr := s.loginAttempts.RegisterFailure(user.Email, metadata.IP); failureErr != nil {
+			return model.Tokens{}, failureErr
+		}
+
+		return model.Tokens{}, err
+	}
+
+	if err := s.loginAttempts.RegisterSuccess(user.Email); err != nil {
+		return model.Tokens{}, err
+	}
+
+	return s.sessions.Create(user.ID, metadata)
+}
+
+func (s *TwoFactorService) checkCode(user *model.User, code string) error {
+	if step, ok := totp.Validate(*user.TOTPSecret, code, time.Now().UTC(), totpSkew); ok {
+		if err := s.repository.UseStep(user.ID, step); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("two-factor code has already been used")
+			}
+
+			return err
+		}
+
+		return nil
+	}
+
+	if err := s.repository.UseRecoveryCode(user.ID, hashToken(normalizeRecoveryCode(code))); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("invalid two-factor code")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Returns a code like "abcd-efgh-ijkl-mnop"
+func generateRecoveryCode() (string, error) {
+	bytes, err := generateRandomBytes(recoveryCodeLength)
+	if err != nil {
+		return "", err
+	}
+
+	code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(bytes))
+
+	groups := make([]string, 0, len(code)/recoveryCodeGroupBy)
+	for i := 0; i < len(code); i += recoveryCodeGroupBy {
+		groups = append(groups, code[i:i+recoveryCodeGroupBy])
+	}
+
+	return strings.Join(groups, "-"), nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+
+	return code
+}
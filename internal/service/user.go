@@ -3,35 +3,55 @@ package service
 import (
 	"database/sql"
 	"errors"
-	"fmt"
-	"os"
 	"regexp"
+	"strings"
 	"time"
 
-	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
 	"github.com/rtsoy/todo-app/internal/model"
 	"github.com/rtsoy/todo-app/internal/repository"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/rtsoy/todo-app/pkg/passhash"
 )
 
 const (
-	bcryptCost        = 12
-	tokenTTL          = 24 * time.Hour
-	minPasswordLength = 8
+	accessTokenTTL    = 15 * time.Minute
+	challengeTokenTTL = 5 * time.Minute
+
+	accessTokenPurpose    = ""
+	challengeTokenPurpose = "2fa"
 )
 
 type UserService struct {
-	repository repository.UserRepository
+	repository    repository.UserRepository
+	sessions      SessionServicer
+	verifications EmailVerificationServicer
+	keys          KeyServicer
+	loginAttempts LoginAttemptServicer
+	passwords     PasswordPolicy
+	hasher        *passhash.Hasher
+	registration  RegistrationPolicy
 }
 
-func NewUserService(repository repository.UserRepository) UserServicer {
+func NewUserService(repository repository.UserRepository, sessions SessionServicer,
+	verifications EmailVerificationServicer, keys KeyServicer, loginAttempts LoginAttemptServicer,
+	passwords PasswordPolicy, hasher *passhash.Hasher, registration RegistrationPolicy) UserServicer {
 	return &UserService{
-		repository: repository,
+		repository:    repository,
+		sessions:      sessions,
+		verifications: verifications,
+		keys:          keys,
+		loginAttempts: loginAttempts,
+		passwords:     passwords,
+		hasher:        hasher,
+		registration:  registration,
 	}
 }
 
 func (u UserService) CreateUser(user model.CreateUserDTO) (uuid.UUID, error) {
+	// Emails and usernames are stored in lower case, so they are unique regardless of case
+	user.Email = normalizeLogin(user.Email)
+	user.Username = normalizeLogin(user.Username)
+
 	// Email validation
 	if ok := isEmailValid(user.Email); !ok {
 		return uuid.Nil, errors.New("email is not valid")
@@ -42,20 +62,35 @@ func (u UserService) CreateUser(user model.CreateUserDTO) (uuid.UUID, error) {
 		return uuid.Nil, errors.New("username is not valid")
 	}
 
+	// Registration mode
+	user.InvitationCode = strings.TrimSpace(user.InvitationCode)
+	if err := u.registration.Check(user.Email, user.InvitationCode != ""); err != nil {
+		return uuid.Nil, err
+	}
+
 	// Password Validation
-	if ok := isPasswordValid(user.Password); !ok {
-		return uuid.Nil, errors.New("password is not valid")
+	if err := u.passwords.Validate(user.Password); err != nil {
+		return uuid.Nil, err
 	}
 
 	// Password hashing
-	hashedPassword, err := hashPassword(user.Password)
+	hashedPassword, err := u.hasher.Hash(user.Password)
 	if err != nil {
 		return uuid.Nil, errors.New("failed to hash password")
 	}
 	user.Password = hashedPassword
 
-	id, err := u.repository.Create(user)
+	var id uuid.UUID
+	if u.registration.Mode == model.RegistrationInvite {
+		id, err = u.repository.CreateWithInvitation(user, hashToken(user.InvitationCode), time.Now().UTC())
+	} else {
+		id, err = u.repository.Create(user)
+	}
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, errors.New("invalid or expired invitation code")
+		}
+
 		if err.Error() == "pq: duplicate key value violates unique constraint \"users_email_key\"" {
 			return uuid.Nil, errors.New("email is already taken")
 		}
@@ -67,58 +102,247 @@ func (u UserService) CreateUser(user model.CreateUserDTO) (uuid.UUID, error) {
 		return uuid.Nil, err
 	}
 
+	// A failed delivery must not fail the sign-up, the user can ask for another email
+	_ = u.verifications.Send(model.User{ID: id, Email: user.Email, Username: user.Username}, user.Email)
+
 	return id, nil
 }
 
-func (u UserService) GenerateToken(email, password string) (string, error) {
-	user, err := u.repository.GetByEmail(email)
+// GenerateToken signs the user in with a login, which is either the email address or the username.
+func (u UserService) GenerateToken(login, password string, metadata model.SessionMetadata) (model.Tokens, error) {
+	login = normalizeLogin(login)
+
+	user, err := u.repository.GetByLogin(login)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return "", errors.New("wrong credentials")
+		if !errors.Is(err, sql.ErrNoRows) {
+			return model.Tokens{}, err
 		}
 
-		return "", err
+		// Unknown logins are counted as well so they lock out the same way
+		if err := u.loginAttempts.Check(login, metadata.IP); err != nil {
+			return model.Tokens{}, err
+		}
+
+		return model.Tokens{}, u.wrongCredentials(login, metadata.IP)
 	}
 
-	if ok := checkPasswordHash(password, user.PasswordHash); !ok {
-		return "", errors.New("wrong credentials")
+	// Failures are counted per account, whichever login was used
+	if err := u.loginAttempts.Check(user.Email, metadata.IP); err != nil {
+		return model.Tokens{}, err
 	}
 
-	claims := jwt.MapClaims{
-		"userID":  user.ID,
-		"expires": time.Now().UTC().Add(tokenTTL),
+	if ok := verifyPassword(u.hasher, password, user.PasswordHash); !ok {
+		return model.Tokens{}, u.wrongCredentials(user.Email, metadata.IP)
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	// The plain password is only known now, so this is the moment to move the hash
+	// to the configured algorithm and cost
+	if u.hasher.NeedsRehash(user.PasswordHash) {
+		if err := u.rehashPassword(user, password); err != nil {
+			return model.Tokens{}, err
+		}
+	}
 
-	secret := os.Getenv("JWT_SECRET")
+	if user.DisabledAt != nil {
+		return model.Tokens{}, errors.New("account is disabled")
+	}
+
+	if user.TOTPEnabledAt != nil {
+		challengeToken, err := generateChallengeToken(u.keys, user.ID)
+		if err != nil {
+			return model.Tokens{}, err
+		}
 
-	return token.SignedString([]byte(secret))
+		// The counter is kept until the second factor succeeds as well
+		return model.Tokens{ChallengeToken: challengeToken, UserID: user.ID}, nil
+	}
+
+	if err := u.loginAttempts.RegisterSuccess(user.Email); err != nil {
+		return model.Tokens{}, err
+	}
+
+	return u.sessions.Create(user.ID, metadata)
 }
 
-func (u UserService) ParseToken(accessToken string) (jwt.MapClaims, error) {
-	token, err := jwt.Parse(accessToken, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
+func (u UserService) wrongCredentials(login, ip string) error {
+	if err := u.loginAttempts.RegisterFailure(login, ip); err != nil {
+		return err
+	}
 
-		secret := os.Getenv("JWT_SECRET")
-		return []byte(secret), nil
-	})
+	return errors.New("wrong credentials")
+}
+
+func (u UserService) rehashPassword(user *model.User, password string) error {
+	hashedPassword, err := u.hasher.Hash(password)
 	if err != nil {
+		return errors.New("failed to hash password")
+	}
+
+	err = u.repository.UpdatePasswordHash(user.ID, user.PasswordHash, hashedPassword)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return nil
+}
+
+func (u UserService) ParseToken(accessToken string) (*model.AccessTokenClaims, error) {
+	var claims model.AccessTokenClaims
+	if err := u.keys.Parse(accessToken, accessTokenPurpose, &claims); err != nil {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		return claims, nil
+	return &claims, nil
+}
+
+func (u UserService) GetByID(userID uuid.UUID) (*model.User, error) {
+	return u.repository.GetByID(userID)
+}
+
+// Update changes the username right away. A new email address is only sent a verification
+// link and replaces the current address once the link is opened.
+func (u UserService) Update(userID uuid.UUID, input model.UpdateUserDTO) (*model.User, error) {
+	user, err := u.repository.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Username != nil {
+		username := normalizeLogin(*input.Username)
+		input.Username = &username
+	}
+
+	if input.Email != nil {
+		email := normalizeLogin(*input.Email)
+		input.Email = &email
+	}
+
+	if input.Username != nil && *input.Username != user.Username {
+		if ok := isUsernameValid(*input.Username); !ok {
+			return nil, errors.New("username is not valid")
+		}
+
+		if err := u.repository.UpdateUsername(userID, *input.Username); err != nil {
+			if err.Error() == "pq: duplicate key value violates unique constraint \"users_username_key\"" {
+				return nil, errors.New("username is already taken")
+			}
+
+			return nil, err
+		}
+
+		user.Username = *input.Username
 	}
 
-	return nil, errors.New("invalid token")
+	if input.Email != nil && *input.Email != user.Email {
+		if ok := isEmailValid(*input.Email); !ok {
+			return nil, errors.New("email is not valid")
+		}
+
+		if _, err := u.repository.GetByEmail(*input.Email); err == nil {
+			return nil, errors.New("email is already taken")
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		if err := u.verifications.Send(*user, *input.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// ChangePassword replaces the password and signs out the other sessions of the user.
+// Accounts created through an identity provider have no password yet and may set one
+// right after signing in.
+func (u UserService) ChangePassword(userID, sessionID uuid.UUID, input model.ChangePasswordDTO, metadata model.SessionMetadata) error {
+	user, err := u.repository.GetByID(userID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.confirmIdentity(user, sessionID, input.CurrentPassword, metadata.IP); err != nil {
+		return err
+	}
+
+	if err := u.passwords.Validate(input.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := u.hasher.Hash(input.NewPassword)
+	if err != nil {
+		return errors.New("failed to hash password")
+	}
+
+	return u.repository.UpdatePassword(userID, sessionID, hashedPassword)
+}
+
+// Delete removes the account with everything that belongs to it.
+func (u UserService) Delete(userID, sessionID uuid.UUID, input model.DeleteUserDTO, metadata model.SessionMetadata) error {
+	user, err := u.repository.GetByID(userID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.confirmIdentity(user, sessionID, input.Password, metadata.IP); err != nil {
+		return err
+	}
+
+	return u.repository.Delete(userID)
 }
 
-// Enforces that the password must be at least 8 characters long
-func isPasswordValid(password string) bool {
-	return len(password) > minPasswordLength
+// confirmIdentity checks the current password before a sensitive action. Wrong passwords
+// count towards the sign-in lockout, otherwise a stolen access token could be used to guess
+// the password. Accounts without a password have to have signed in recently instead.
+func (u UserService) confirmIdentity(user *model.User, sessionID uuid.UUID, password, ip string) error {
+	if user.PasswordHash == "" {
+		return u.sessions.RequireRecentSignIn(user.ID, sessionID)
+	}
+
+	if err := u.loginAttempts.Check(user.Email, ip); err != nil {
+		return err
+	}
+
+	if ok := verifyPassword(u.hasher, password, user.PasswordHash); !ok {
+		if err := u.loginAttempts.RegisterFailure(user.Email, ip); err != nil {
+			return err
+		}
+
+		return errors.New("wrong password")
+	}
+
+	return u.loginAttempts.RegisterSuccess(user.Email)
+}
+
+// Issues a short-lived access token bound to the session it was created for
+func generateAccessToken(keys KeyServicer, user *model.User, sessionID uuid.UUID) (string, error) {
+	claims := model.AccessTokenClaims{
+		RegisteredClaims: model.RegisteredClaims{Subject: user.ID.String()},
+		SessionID:        sessionID.String(),
+		Role:             user.Role,
+	}
+
+	return keys.Sign(&claims, accessTokenPurpose, accessTokenTTL)
+}
+
+// Issues a token that only proves the password step of a two-factor sign-in
+func generateChallengeToken(keys KeyServicer, userID uuid.UUID) (string, error) {
+	claims := model.RegisteredClaims{Subject: userID.String()}
+
+	return keys.Sign(&claims, challengeTokenPurpose, challengeTokenTTL)
+}
+
+func parseChallengeToken(keys KeyServicer, challengeToken string) (uuid.UUID, error) {
+	var claims model.RegisteredClaims
+	if err := keys.Parse(challengeToken, challengeTokenPurpose, &claims); err != nil {
+		if err.Error() == "token is expired" {
+			return uuid.Nil, errors.New("challenge token is expired")
+		}
+
+		return uuid.Nil, errors.New("invalid challenge token")
+	}
+
+	return uuid.Parse(claims.Subject)
 }
 
 // Ensures that the input string contains only letters (uppercase and lowercase),
@@ -135,11 +359,14 @@ func isEmailValid(email string) bool {
 	return emailRegex.MatchString(email)
 }
 
-func checkPasswordHash(password, hash string) bool {
-	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
-}
+// verifyPassword checks the password against a hash of any supported algorithm. Accounts
+// created through an identity provider have no hash and never match.
+func verifyPassword(hasher *passhash.Hasher, password, hash string) bool {
+	if hash == "" {
+		return false
+	}
+
+	ok, err := hasher.Verify(password, hash)
 
-func hashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
-	return string(bytes), err
+	return err == nil && ok
 }
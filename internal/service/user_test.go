@@ -0,0 +1,120 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rtsoy/todo-app/internal/model"
+	mock_repository "github.com/rtsoy/todo-app/internal/repository/mocks"
+	mock_service "github.com/rtsoy/todo-app/internal/service/mocks"
+	"github.com/rtsoy/todo-app/pkg/passhash"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserService_Delete(t *testing.T) {
+	type mockBehavior func(users *mock_repository.MockUserRepository, sessions *mock_service.MockSessionServicer,
+		loginAttempts *mock_service.MockLoginAttemptServicer, user *model.User, sessionID uuid.UUID)
+
+	hasher, err := passhash.NewHasher(passhash.Bcrypt, 4, passhash.Argon2idParams{})
+	assert.NoError(t, err)
+
+	passwordHash, err := hasher.Hash("qwerty123")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		user          *model.User
+		password      string
+		mockBehavior  mockBehavior
+		expectedError string
+	}{
+		{
+			name:     "OK",
+			user:     &model.User{ID: uuid.New(), Email: "test@example.com", PasswordHash: passwordHash},
+			password: "qwerty123",
+			mockBehavior: func(users *mock_repository.MockUserRepository, sessions *mock_service.MockSessionServicer,
+				loginAttempts *mock_service.MockLoginAttemptServicer, user *model.User, sessionID uuid.UUID) {
+				users.EXPECT().GetByID(user.ID).Return(user, nil)
+				loginAttempts.EXPECT().Check(user.Email, "192.0.2.1").Return(nil)
+				loginAttempts.EXPECT().RegisterSuccess(user.Email).Return(nil)
+				users.EXPECT().Delete(user.ID).Return(nil)
+			},
+		},
+		{
+			name:     "Wrong Password",
+			user:     &model.User{ID: uuid.New(), Email: "test@example.com", PasswordHash: passwordHash},
+			password: "wrong",
+			mockBehavior: func(users *mock_repository.MockUserRepository, sessions *mock_service.MockSessionServicer,
+				loginAttempts *mock_service.MockLoginAttemptServicer, user *model.User, sessionID uuid.UUID) {
+				users.EXPECT().GetByID(user.ID).Return(user, nil)
+				loginAttempts.EXPECT().Check(user.Email, "192.0.2.1").Return(nil)
+				loginAttempts.EXPECT().RegisterFailure(user.Email, "192.0.2.1").Return(nil)
+			},
+			expectedError: "wrong password",
+		},
+		{
+			name:     "Locked Out",
+			user:     &model.User{ID: uuid.New(), Email: "test@example.com", PasswordHash: passwordHash},
+			password: "qwerty123",
+			mockBehavior: func(users *mock_repository.MockUserRepository, sessions *mock_service.MockSessionServicer,
+				loginAttempts *mock_service.MockLoginAttemptServicer, user *model.User, sessionID uuid.UUID) {
+				users.EXPECT().GetByID(user.ID).Return(user, nil)
+				loginAttempts.EXPECT().Check(user.Email, "192.0.2.1").Return(&model.LockedOutError{RetryAfter: time.Minute})
+			},
+			expectedError: "too many failed sign-in attempts, try again later",
+		},
+		{
+			name: "No Password Recent Sign In",
+			user: &model.User{ID: uuid.New(), Email: "test@example.com"},
+			mockBehavior: func(users *mock_repository.MockUserRepository, sessions *mock_service.MockSessionServicer,
+				loginAttempts *mock_service.MockLoginAttemptServicer, user *model.User, sessionID uuid.UUID) {
+				users.EXPECT().GetByID(user.ID).Return(user, nil)
+				sessions.EXPECT().RequireRecentSignIn(user.ID, sessionID).Return(nil)
+				users.EXPECT().Delete(user.ID).Return(nil)
+			},
+		},
+		{
+			name: "No Password Stale Session",
+			user: &model.User{ID: uuid.New(), Email: "test@example.com"},
+			mockBehavior: func(users *mock_repository.MockUserRepository, sessions *mock_service.MockSessionServicer,
+				loginAttempts *mock_service.MockLoginAttemptServicer, user *model.User, sessionID uuid.UUID) {
+				users.EXPECT().GetByID(user.ID).Return(user, nil)
+				sessions.EXPECT().RequireRecentSignIn(user.ID, sessionID).Return(
+					errors.New("sign in again to confirm this action"))
+			},
+			expectedError: "sign in again to confirm this action",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := gomock.NewController(t)
+			defer c.Finish()
+			sessionID := uuid.New()
+
+			users := mock_repository.NewMockUserRepository(c)
+			sessions := mock_service.NewMockSessionServicer(c)
+			loginAttempts := mock_service.NewMockLoginAttemptServicer(c)
+			test.mockBehavior(users, sessions, loginAttempts, test.user, sessionID)
+
+			s := UserService{
+				repository:    users,
+				sessions:      sessions,
+				loginAttempts: loginAttempts,
+				hasher:        hasher,
+			}
+
+			err := s.Delete(test.user.ID, sessionID, model.DeleteUserDTO{Password: test.password},
+				model.SessionMetadata{IP: "192.0.2.1"})
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
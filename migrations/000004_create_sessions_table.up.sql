@@ -0,0 +1,9 @@
+CREATE TABLE sessions
+(
+    id                 UUID                                         NOT NULL PRIMARY KEY,
+    user_id            UUID REFERENCES users (id) ON DELETE CASCADE NOT NULL,
+    refresh_token_hash VARCHAR(255)                                 NOT NULL UNIQUE,
+    created_at         TIMESTAMP                                    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at         TIMESTAMP                                    NOT NULL,
+    revoked_at         TIMESTAMP
+);
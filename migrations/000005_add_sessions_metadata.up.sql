@@ -0,0 +1,4 @@
+ALTER TABLE sessions
+    ADD COLUMN user_agent   VARCHAR(512) NOT NULL DEFAULT '',
+    ADD COLUMN ip           VARCHAR(64)  NOT NULL DEFAULT '',
+    ADD COLUMN last_seen_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP;
@@ -0,0 +1,9 @@
+CREATE TABLE password_reset_tokens
+(
+    id         UUID                                         NOT NULL PRIMARY KEY,
+    user_id    UUID REFERENCES users (id) ON DELETE CASCADE NOT NULL,
+    token_hash VARCHAR(255)                                 NOT NULL UNIQUE,
+    created_at TIMESTAMP                                    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP                                    NOT NULL,
+    used_at    TIMESTAMP
+);
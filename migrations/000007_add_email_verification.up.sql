@@ -0,0 +1,18 @@
+ALTER TABLE users
+    ADD COLUMN verified_at TIMESTAMP;
+
+-- Accounts that exist before verification was introduced are treated as verified,
+-- otherwise turning on a verification policy would lock all of them out
+UPDATE users
+SET verified_at = CURRENT_TIMESTAMP;
+
+CREATE TABLE email_verification_tokens
+(
+    id         UUID                                         NOT NULL PRIMARY KEY,
+    user_id    UUID REFERENCES users (id) ON DELETE CASCADE NOT NULL,
+    email      VARCHAR(255)                                 NOT NULL,
+    token_hash VARCHAR(255)                                 NOT NULL UNIQUE,
+    created_at TIMESTAMP                                    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP                                    NOT NULL,
+    used_at    TIMESTAMP
+);
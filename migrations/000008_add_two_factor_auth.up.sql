@@ -0,0 +1,14 @@
+ALTER TABLE users
+    ADD COLUMN totp_secret     VARCHAR(64),
+    ADD COLUMN totp_enabled_at TIMESTAMP,
+    ADD COLUMN totp_last_step  BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE recovery_codes
+(
+    id         UUID                                         NOT NULL PRIMARY KEY,
+    user_id    UUID REFERENCES users (id) ON DELETE CASCADE NOT NULL,
+    code_hash  VARCHAR(255)                                 NOT NULL,
+    created_at TIMESTAMP                                    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    used_at    TIMESTAMP,
+    UNIQUE (user_id, code_hash)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE personal_access_tokens
+(
+    id           UUID                                         NOT NULL PRIMARY KEY,
+    user_id      UUID REFERENCES users (id) ON DELETE CASCADE NOT NULL,
+    name         VARCHAR(255)                                 NOT NULL,
+    token_hash   VARCHAR(255)                                 NOT NULL UNIQUE,
+    scopes       TEXT[]                                       NOT NULL,
+    created_at   TIMESTAMP                                    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at   TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at   TIMESTAMP
+);
@@ -0,0 +1,10 @@
+CREATE TABLE user_identities
+(
+    id         UUID                                         NOT NULL PRIMARY KEY,
+    user_id    UUID REFERENCES users (id) ON DELETE CASCADE NOT NULL,
+    provider   VARCHAR(255)                                 NOT NULL,
+    subject    VARCHAR(255)                                 NOT NULL,
+    email      VARCHAR(255)                                 NOT NULL,
+    created_at TIMESTAMP                                    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (provider, subject)
+);
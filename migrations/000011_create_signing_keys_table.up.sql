@@ -0,0 +1,9 @@
+CREATE TABLE signing_keys
+(
+    id          VARCHAR(255) NOT NULL PRIMARY KEY,
+    algorithm   VARCHAR(16)  NOT NULL,
+    private_key TEXT         NOT NULL,
+    created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    retired_at  TIMESTAMP,
+    expires_at  TIMESTAMP
+);
@@ -0,0 +1,7 @@
+CREATE TABLE login_attempts
+(
+    key             VARCHAR(320) NOT NULL PRIMARY KEY,
+    failures        INTEGER      NOT NULL,
+    last_failure_at TIMESTAMP    NOT NULL,
+    locked_until    TIMESTAMP
+);
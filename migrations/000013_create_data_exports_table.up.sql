@@ -0,0 +1,12 @@
+CREATE TABLE data_exports
+(
+    id           UUID                                         NOT NULL PRIMARY KEY,
+    user_id      UUID REFERENCES users (id) ON DELETE CASCADE NOT NULL,
+    status       VARCHAR(16)                                  NOT NULL,
+    archive      BYTEA,
+    created_at   TIMESTAMP                                    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP,
+    expires_at   TIMESTAMP
+);
+
+CREATE UNIQUE INDEX data_exports_pending_user_id_key ON data_exports (user_id) WHERE status = 'pending';
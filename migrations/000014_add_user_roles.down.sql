@@ -0,0 +1,4 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS role,
+    DROP COLUMN IF EXISTS disabled_at,
+    DROP COLUMN IF EXISTS created_at;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE users
+    ADD COLUMN role        VARCHAR(16) NOT NULL DEFAULT 'user',
+    ADD COLUMN disabled_at TIMESTAMP,
+    ADD COLUMN created_at  TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP;
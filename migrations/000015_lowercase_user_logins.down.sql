@@ -0,0 +1,3 @@
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_email_lowercase,
+    DROP CONSTRAINT IF EXISTS users_username_lowercase;
\ No newline at end of file
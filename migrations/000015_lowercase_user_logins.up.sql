@@ -0,0 +1,10 @@
+-- Fails on accounts that only differ by case, those have to be merged or renamed first
+UPDATE users
+SET email    = LOWER(email),
+    username = LOWER(username)
+WHERE email != LOWER(email)
+   OR username != LOWER(username);
+
+ALTER TABLE users
+    ADD CONSTRAINT users_email_lowercase CHECK (email = LOWER(email)),
+    ADD CONSTRAINT users_username_lowercase CHECK (username = LOWER(username));
@@ -0,0 +1,11 @@
+CREATE TABLE invitations
+(
+    id         UUID                                          NOT NULL PRIMARY KEY,
+    code_hash  VARCHAR(255)                                  NOT NULL UNIQUE,
+    created_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    max_uses   INT                                           NOT NULL CHECK (max_uses > 0),
+    uses       INT                                           NOT NULL DEFAULT 0,
+    created_at TIMESTAMP                                     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
@@ -0,0 +1,32 @@
+CREATE TABLE audit_events
+(
+    id          UUID         NOT NULL PRIMARY KEY,
+    actor_id    UUID,
+    user_id     UUID,
+    action      VARCHAR(64)  NOT NULL,
+    target_type VARCHAR(32)  NOT NULL DEFAULT '',
+    target_id   VARCHAR(255) NOT NULL DEFAULT '',
+    ip          VARCHAR(64)  NOT NULL DEFAULT '',
+    user_agent  TEXT         NOT NULL DEFAULT '',
+    outcome     VARCHAR(16)  NOT NULL,
+    reason      TEXT         NOT NULL DEFAULT '',
+    created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Users are referenced without foreign keys, so the record outlives deleted accounts
+CREATE INDEX audit_events_user_id_created_at_idx ON audit_events (user_id, created_at DESC);
+CREATE INDEX audit_events_actor_id_created_at_idx ON audit_events (actor_id, created_at DESC);
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at DESC);
+
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE
+    ON audit_events
+    FOR EACH ROW
+EXECUTE FUNCTION audit_events_append_only();
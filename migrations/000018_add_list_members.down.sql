@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS users_lists_owner_key;
+
+ALTER TABLE users_lists
+    DROP CONSTRAINT IF EXISTS users_lists_user_id_list_id_key,
+    DROP COLUMN IF EXISTS role,
+    DROP COLUMN IF EXISTS created_at;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- Every existing row links a list to the user who created it
+ALTER TABLE users_lists
+    ADD COLUMN role       VARCHAR(16) NOT NULL DEFAULT 'owner',
+    ADD COLUMN created_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD CONSTRAINT users_lists_user_id_list_id_key UNIQUE (user_id, list_id);
+
+ALTER TABLE users_lists
+    ALTER COLUMN role DROP DEFAULT;
+
+CREATE UNIQUE INDEX users_lists_owner_key ON users_lists (list_id) WHERE role = 'owner';
@@ -0,0 +1,4 @@
+ALTER TABLE users_lists
+    DROP CONSTRAINT IF EXISTS users_lists_role_check;
+
+UPDATE users_lists SET role = 'member' WHERE role != 'owner';
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Members added before roles existed could edit the list
+UPDATE users_lists SET role = 'editor' WHERE role = 'member';
+
+ALTER TABLE users_lists
+    ADD CONSTRAINT users_lists_role_check CHECK (role IN ('owner', 'editor', 'commenter', 'viewer'));
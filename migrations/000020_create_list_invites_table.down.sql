@@ -0,0 +1 @@
+DROP TABLE IF EXISTS list_invites;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE list_invites
+(
+    id         UUID                                               NOT NULL PRIMARY KEY,
+    list_id    UUID REFERENCES todo_lists (id) ON DELETE CASCADE  NOT NULL,
+    token_hash VARCHAR(255)                                       NOT NULL UNIQUE,
+    role       VARCHAR(16)                                        NOT NULL
+        CHECK (role IN ('editor', 'commenter', 'viewer')),
+    created_by UUID REFERENCES users (id) ON DELETE SET NULL,
+    max_uses   INT                                                NOT NULL CHECK (max_uses > 0),
+    uses       INT                                                NOT NULL DEFAULT 0,
+    created_at TIMESTAMP                                          NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX list_invites_list_id_idx ON list_invites (list_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS list_transfers;
\ No newline at end of file
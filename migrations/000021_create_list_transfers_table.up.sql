@@ -0,0 +1,12 @@
+-- A list has at most one pending transfer, a new request replaces the previous one
+CREATE TABLE list_transfers
+(
+    id           UUID                                              NOT NULL PRIMARY KEY,
+    list_id      UUID REFERENCES todo_lists (id) ON DELETE CASCADE NOT NULL UNIQUE,
+    from_user_id UUID REFERENCES users (id) ON DELETE CASCADE      NOT NULL,
+    to_user_id   UUID REFERENCES users (id) ON DELETE CASCADE      NOT NULL,
+    created_at   TIMESTAMP                                         NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at   TIMESTAMP                                         NOT NULL
+);
+
+CREATE INDEX list_transfers_to_user_id_idx ON list_transfers (to_user_id);
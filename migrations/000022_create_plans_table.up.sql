@@ -0,0 +1,17 @@
+-- Quotas are overwritten with the configured values on startup
+CREATE TABLE plans
+(
+    name                 VARCHAR(16) NOT NULL PRIMARY KEY,
+    max_lists            INT         NOT NULL CHECK (max_lists >= 0),
+    max_items_per_list   INT         NOT NULL CHECK (max_items_per_list >= 0),
+    max_members_per_list INT         NOT NULL CHECK (max_members_per_list >= 0),
+    max_attachments      INT         NOT NULL CHECK (max_attachments >= 0)
+);
+
+INSERT INTO plans (name, max_lists, max_items_per_list, max_members_per_list, max_attachments)
+VALUES ('free', 5, 100, 3, 10),
+       ('pro', 50, 1000, 10, 1000),
+       ('team', 500, 5000, 50, 10000);
+
+ALTER TABLE users
+    ADD COLUMN plan VARCHAR(16) NOT NULL DEFAULT 'free' REFERENCES plans (name);
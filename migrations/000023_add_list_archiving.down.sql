@@ -0,0 +1,2 @@
+ALTER TABLE todo_lists
+    DROP COLUMN IF EXISTS archived_at;
\ No newline at end of file
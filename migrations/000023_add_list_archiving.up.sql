@@ -0,0 +1,3 @@
+-- Archived lists are read-only and do not count towards the list quota
+ALTER TABLE todo_lists
+    ADD COLUMN archived_at TIMESTAMP;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS todo_items_deleted_at_idx;
+DROP INDEX IF EXISTS todo_lists_deleted_at_idx;
+
+ALTER TABLE todo_items
+    DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE todo_lists
+    DROP COLUMN IF EXISTS deleted_at;
\ No newline at end of file
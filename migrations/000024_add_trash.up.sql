@@ -0,0 +1,9 @@
+-- Deleted lists and items stay in the trash until they are purged after the retention period
+ALTER TABLE todo_lists
+    ADD COLUMN deleted_at TIMESTAMP;
+
+ALTER TABLE todo_items
+    ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX todo_lists_deleted_at_idx ON todo_lists (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX todo_items_deleted_at_idx ON todo_items (deleted_at) WHERE deleted_at IS NOT NULL;
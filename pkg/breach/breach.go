@@ -0,0 +1,82 @@
+// Package breach looks passwords up in a local copy of a breached password corpus.
+//
+// The corpus is laid out like the k-anonymity range API of Have I Been Pwned: the
+// uppercase hex SHA-1 hash of a password is split after 5 characters, the prefix names
+// a file and every line of that file holds a remaining suffix and how often it was seen,
+// "SUFFIX:COUNT". Only the file of one prefix is read per lookup, so the whole corpus
+// never has to fit into memory.
+package breach
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const prefixLength = 5
+
+type List struct {
+	dir string
+}
+
+// NewList reads range files from dir, named after the prefix with or without a .txt extension.
+func NewList(dir string) *List {
+	return &List{
+		dir: dir,
+	}
+}
+
+// Count returns how often the password appears in the corpus, 0 if it does not.
+func (l *List) Count(password string) (int, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	prefix, suffix := hash[:prefixLength], hash[prefixLength:]
+
+	file, err := l.open(prefix)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		hashSuffix, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !strings.EqualFold(hashSuffix, suffix) {
+			continue
+		}
+
+		// A line without a count still marks the password as breached
+		if !found {
+			return 1, nil
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || n < 1 {
+			return 1, nil
+		}
+
+		return n, nil
+	}
+
+	return 0, scanner.Err()
+}
+
+func (l *List) open(prefix string) (*os.File, error) {
+	file, err := os.Open(filepath.Join(l.dir, prefix))
+	if errors.Is(err, fs.ErrNotExist) {
+		return os.Open(filepath.Join(l.dir, prefix+".txt"))
+	}
+
+	return file, err
+}
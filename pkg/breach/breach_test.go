@@ -0,0 +1,41 @@
+package breach
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+func TestCount(t *testing.T) {
+	dir := t.TempDir()
+
+	rangeFile := "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n" +
+		"1e4c9b93f3f0682250b6cf8331b7ee68fd8:3730471\r\n" +
+		"011053FD0102E94D6AE2F8B83D76FAF94F6:1\r\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "5BAA6"), []byte(rangeFile), 0o600))
+
+	// SHA-1 of "123456" is 7C4A8D09CA3762AF61E59520943DC26494F8941B
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "7C4A8.txt"), []byte("D09CA3762AF61E59520943DC26494F8941B\n"), 0o600))
+
+	list := NewList(dir)
+
+	tests := []struct {
+		password string
+		expected int
+	}{
+		{password: "password", expected: 3730471},
+		{password: "123456", expected: 1},
+		{password: "Password", expected: 0},
+		{password: "correct horse battery staple", expected: 0},
+	}
+
+	for _, test := range tests {
+		count, err := list.Count(test.password)
+
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, count, test.password)
+	}
+}
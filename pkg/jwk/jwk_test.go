@@ -0,0 +1,68 @@
+package jwk
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, alg := range []string{AlgorithmRS256, AlgorithmEdDSA} {
+		t.Run(alg, func(t *testing.T) {
+			privateKey, err := GenerateKey(alg)
+			require.NoError(t, err)
+
+			encoded, err := MarshalPrivateKey(privateKey)
+			require.NoError(t, err)
+
+			parsed, err := ParsePrivateKey(encoded)
+			require.NoError(t, err)
+			assert.Equal(t, privateKey.Public(), parsed.Public())
+
+			key, err := New("kid", alg, privateKey.Public())
+			require.NoError(t, err)
+			assert.Equal(t, "kid", key.Kid)
+			assert.Equal(t, alg, key.Alg)
+			assert.Equal(t, "sig", key.Use)
+
+			data, err := json.Marshal(Set{Keys: []Key{key}})
+			require.NoError(t, err)
+
+			var set Set
+			require.NoError(t, json.Unmarshal(data, &set))
+
+			publicKey, err := set.Keys[0].PublicKey()
+			require.NoError(t, err)
+			assert.Equal(t, privateKey.Public(), publicKey)
+		})
+	}
+}
+
+func TestRoundTrip_ECDSA(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	key, err := New("kid", "ES256", privateKey.Public())
+	require.NoError(t, err)
+	assert.Equal(t, "EC", key.Kty)
+	assert.Equal(t, "P-256", key.Crv)
+
+	publicKey, err := key.PublicKey()
+	require.NoError(t, err)
+	assert.True(t, privateKey.PublicKey.Equal(publicKey))
+}
+
+func TestGenerateKey_UnsupportedAlgorithm(t *testing.T) {
+	_, err := GenerateKey("HS256")
+	assert.EqualError(t, err, "unsupported signing algorithm: HS256")
+}
+
+func TestKey_PublicKey_Unsupported(t *testing.T) {
+	_, err := Key{Kty: "oct"}.PublicKey()
+	assert.EqualError(t, err, "unsupported key type: oct")
+}
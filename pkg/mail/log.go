@@ -0,0 +1,30 @@
+package mail
+
+import (
+	"io"
+	"sync"
+)
+
+// LogSender writes messages to w instead of delivering them.
+// It is meant for local development and tests.
+type LogSender struct {
+	mu   sync.Mutex
+	w    io.Writer
+	from string
+}
+
+func NewLogSender(w io.Writer, from string) *LogSender {
+	return &LogSender{
+		w:    w,
+		from: from,
+	}
+}
+
+func (s *LogSender) Send(message Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.w.Write(append(buildMessage(s.from, message), "\r\n\r\n"...))
+
+	return err
+}
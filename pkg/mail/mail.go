@@ -0,0 +1,39 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rtsoy/todo-app/config"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Sender interface {
+	Send(message Message) error
+}
+
+// New returns the sender selected by MAIL_DRIVER.
+func New(cfg *config.Config) (Sender, error) {
+	switch cfg.MailDriver {
+	case "smtp":
+		return NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom), nil
+	case "log", "":
+		if cfg.MailLogFile == "" {
+			return NewLogSender(os.Stdout, cfg.MailFrom), nil
+		}
+
+		file, err := os.OpenFile(cfg.MailLogFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewLogSender(file, cfg.MailFrom), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver: %s", cfg.MailDriver)
+	}
+}
@@ -0,0 +1,249 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/rtsoy/todo-app/pkg/jwk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID     = "todo-app"
+	testClientSecret = "secret"
+	testRedirectURL  = "http://localhost:3000/auth/oidc/mock/callback"
+	testCode         = "authorization-code"
+)
+
+// mockIssuer is a minimal identity provider serving discovery, keys and a token
+// endpoint that checks the PKCE verifier against the challenge it was given.
+type mockIssuer struct {
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	kid       string
+	challenge string
+	claims    jwt.MapClaims
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer := &mockIssuer{key: key, kid: "key-1"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, Metadata{
+			Issuer:                issuer.server.URL,
+			AuthorizationEndpoint: issuer.server.URL + "/authorize",
+			TokenEndpoint:         issuer.server.URL + "/token",
+			JWKSURI:               issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		key, err := jwk.New(issuer.kid, jwk.AlgorithmRS256, issuer.key.Public())
+		require.NoError(t, err)
+
+		writeJSON(w, jwk.Set{Keys: []jwk.Key{key}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, _ := r.BasicAuth()
+		if clientID != testClientID || clientSecret != testClientSecret {
+			http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+
+		if r.FormValue("code") != testCode || ChallengeS256(r.FormValue("code_verifier")) != issuer.challenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+
+		writeJSON(w, Token{
+			AccessToken: "access-token",
+			TokenType:   "Bearer",
+			IDToken:     issuer.sign(t, issuer.claims),
+		})
+	})
+
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+
+	return issuer
+}
+
+func (i *mockIssuer) sign(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = i.kid
+
+	signed, err := token.SignedString(i.key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func (i *mockIssuer) idClaims(nonce string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            i.server.URL,
+		"aud":            testClientID,
+		"sub":            "external-user",
+		"email":          "user@example.com",
+		"email_verified": true,
+		"nonce":          nonce,
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(time.Minute).Unix(),
+	}
+}
+
+func (i *mockIssuer) provider() *Provider {
+	return NewProvider(Config{
+		IssuerURL:    i.server.URL,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"openid", "email"},
+	}, nil)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestProvider_AuthorizationCodeFlow(t *testing.T) {
+	issuer := newMockIssuer(t)
+	provider := issuer.provider()
+
+	verifier, err := GenerateVerifier()
+	require.NoError(t, err)
+	issuer.challenge = ChallengeS256(verifier)
+	issuer.claims = issuer.idClaims("nonce")
+
+	authURL, err := provider.AuthCodeURL("state", "nonce", issuer.challenge)
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/authorize", parsed.Path)
+	assert.Equal(t, "code", parsed.Query().Get("response_type"))
+	assert.Equal(t, testClientID, parsed.Query().Get("client_id"))
+	assert.Equal(t, testRedirectURL, parsed.Query().Get("redirect_uri"))
+	assert.Equal(t, "openid email", parsed.Query().Get("scope"))
+	assert.Equal(t, "state", parsed.Query().Get("state"))
+	assert.Equal(t, issuer.challenge, parsed.Query().Get("code_challenge"))
+	assert.Equal(t, "S256", parsed.Query().Get("code_challenge_method"))
+
+	token, err := provider.Exchange(testCode, verifier)
+	require.NoError(t, err)
+
+	claims, err := provider.VerifyIDToken(token.IDToken, "nonce")
+	require.NoError(t, err)
+	assert.Equal(t, &Claims{Subject: "external-user", Email: "user@example.com", EmailVerified: true}, claims)
+}
+
+func TestProvider_Exchange_WrongVerifier(t *testing.T) {
+	issuer := newMockIssuer(t)
+	provider := issuer.provider()
+
+	issuer.challenge = ChallengeS256("verifier")
+	issuer.claims = issuer.idClaims("nonce")
+
+	_, err := provider.Exchange(testCode, "another-verifier")
+	assert.Error(t, err)
+}
+
+func TestProvider_VerifyIDToken(t *testing.T) {
+	issuer := newMockIssuer(t)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		token    func() string
+		nonce    string
+		expected string
+	}{
+		{
+			name:  "OK",
+			token: func() string { return issuer.sign(t, issuer.idClaims("nonce")) },
+			nonce: "nonce",
+		},
+		{
+			name:     "Wrong Nonce",
+			token:    func() string { return issuer.sign(t, issuer.idClaims("nonce")) },
+			nonce:    "another-nonce",
+			expected: "id token nonce does not match",
+		},
+		{
+			name: "Wrong Audience",
+			token: func() string {
+				claims := issuer.idClaims("nonce")
+				claims["aud"] = []string{"another-client"}
+				return issuer.sign(t, claims)
+			},
+			nonce:    "nonce",
+			expected: "id token has an unexpected audience",
+		},
+		{
+			name: "Wrong Issuer",
+			token: func() string {
+				claims := issuer.idClaims("nonce")
+				claims["iss"] = "https://evil.example.com"
+				return issuer.sign(t, claims)
+			},
+			nonce:    "nonce",
+			expected: "id token has an unexpected issuer",
+		},
+		{
+			name: "Expired",
+			token: func() string {
+				claims := issuer.idClaims("nonce")
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+				return issuer.sign(t, claims)
+			},
+			nonce:    "nonce",
+			expected: "invalid id token: Token is expired",
+		},
+		{
+			name: "Foreign Key",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodRS256, issuer.idClaims("nonce"))
+				token.Header["kid"] = issuer.kid
+				signed, _ := token.SignedString(otherKey)
+				return signed
+			},
+			nonce:    "nonce",
+			expected: "invalid id token: crypto/rsa: verification error",
+		},
+		{
+			name: "Unsigned",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodNone, issuer.idClaims("nonce"))
+				signed, _ := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+				return signed
+			},
+			nonce:    "nonce",
+			expected: "invalid id token: unexpected signing method: none",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := issuer.provider().VerifyIDToken(test.token(), test.nonce)
+			if test.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package passhash
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testArgon2idParams = Argon2idParams{
+	Memory:      1024,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestHasher_Argon2id(t *testing.T) {
+	hasher, err := NewHasher(Argon2id, bcrypt.MinCost, testArgon2idParams)
+	assert.NoError(t, err)
+
+	hash, err := hasher.Hash("correct horse")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	ok, err := hasher.Verify("correct horse", hash)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = hasher.Verify("wrong horse", hash)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.False(t, hasher.NeedsRehash(hash))
+
+	stronger := testArgon2idParams
+	stronger.Iterations = 2
+	strongerHasher, err := NewHasher(Argon2id, bcrypt.MinCost, stronger)
+	assert.NoError(t, err)
+
+	assert.True(t, strongerHasher.NeedsRehash(hash))
+
+	// Hashes keep verifying with other parameters configured
+	ok, err = strongerHasher.Verify("correct horse", hash)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestHasher_Bcrypt(t *testing.T) {
+	hasher, err := NewHasher(Bcrypt, bcrypt.MinCost, testArgon2idParams)
+	assert.NoError(t, err)
+
+	hash, err := hasher.Hash("correct horse")
+	assert.NoError(t, err)
+
+	ok, err := hasher.Verify("correct horse", hash)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = hasher.Verify("wrong horse", hash)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.False(t, hasher.NeedsRehash(hash))
+
+	costlier, err := NewHasher(Bcrypt, bcrypt.MinCost+1, testArgon2idParams)
+	assert.NoError(t, err)
+	assert.True(t, costlier.NeedsRehash(hash))
+}
+
+func TestHasher_Upgrade(t *testing.T) {
+	legacy, err := NewHasher(Bcrypt, bcrypt.MinCost, testArgon2idParams)
+	assert.NoError(t, err)
+
+	hash, err := legacy.Hash("correct horse")
+	assert.NoError(t, err)
+
+	hasher, err := NewHasher(Argon2id, bcrypt.MinCost, testArgon2idParams)
+	assert.NoError(t, err)
+
+	ok, err := hasher.Verify("correct horse", hash)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, hasher.NeedsRehash(hash))
+
+	_, err = hasher.Verify("correct horse", "plaintext")
+	assert.ErrorIs(t, err, ErrUnknownHash)
+}
+
+func TestNewHasher(t *testing.T) {
+	_, err := NewHasher("md5", bcrypt.DefaultCost, DefaultArgon2idParams)
+	assert.Error(t, err)
+
+	_, err = NewHasher(Bcrypt, 100, DefaultArgon2idParams)
+	assert.Error(t, err)
+
+	_, err = NewHasher(Argon2id, bcrypt.DefaultCost, Argon2idParams{})
+	assert.Error(t, err)
+}
+
+func TestHasher_MalformedArgon2id(t *testing.T) {
+	hasher, err := NewHasher(Argon2id, bcrypt.MinCost, testArgon2idParams)
+	assert.NoError(t, err)
+
+	hashes := []string{
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$",
+		"$argon2id$v=19$m=1024,t=1,p=1$$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U",
+		"$argon2id$v=19$m=0,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U",
+		"$argon2id$v=19$m=1024,t=0,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U",
+		"$argon2id$v=19$m=1024,t=1,p=0$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U",
+	}
+
+	for _, hash := range hashes {
+		ok, err := hasher.Verify("any password", hash)
+		assert.ErrorIs(t, err, ErrUnknownHash, hash)
+		assert.False(t, ok, hash)
+		assert.True(t, hasher.NeedsRehash(hash), hash)
+	}
+}